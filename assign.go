@@ -0,0 +1,62 @@
+package jagain
+
+import (
+	"encoding"
+	"encoding/json"
+	"fmt"
+	"reflect"
+)
+
+// assignValue stores a loosely typed decoded value, such as one produced by a TOML or
+// GraphQL parser, into dst. Scalars are assigned or converted directly, strings go through
+// encoding.TextUnmarshaler when dst implements it, and maps and slices fall back to a
+// JSON round trip.
+func assignValue(data any, dst any) error {
+	if s, ok := data.(string); ok {
+		if u, ok := dst.(encoding.TextUnmarshaler); ok {
+			return u.UnmarshalText([]byte(s))
+		}
+	}
+
+	target := reflect.ValueOf(dst).Elem()
+	if n, ok := data.(json.Number); ok && isNumberKind(target.Kind()) {
+		return json.Unmarshal([]byte(n), dst)
+	}
+
+	src := reflect.ValueOf(data)
+	if !src.IsValid() {
+		return fmt.Errorf("cannot assign empty value to %s", target.Type())
+	}
+	if src.Type().AssignableTo(target.Type()) {
+		target.Set(src)
+		return nil
+	}
+	if isNumberKind(src.Kind()) && isNumberKind(target.Kind()) {
+		converted := src.Convert(target.Type())
+		if !converted.Convert(src.Type()).Equal(src) || (src.CanInt() && src.Int() < 0 && converted.CanUint()) {
+			return fmt.Errorf("value %v overflows %s", data, target.Type())
+		}
+		target.Set(converted)
+		return nil
+	}
+
+	switch src.Kind() {
+	case reflect.Map, reflect.Slice:
+		raw, err := json.Marshal(data)
+		if err != nil {
+			return err
+		}
+		return json.Unmarshal(raw, dst)
+	}
+	return fmt.Errorf("cannot assign %T to %s", data, target.Type())
+}
+
+func isNumberKind(k reflect.Kind) bool {
+	switch k {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64,
+		reflect.Float32, reflect.Float64:
+		return true
+	}
+	return false
+}
@@ -0,0 +1,61 @@
+package jagain
+
+import (
+	"errors"
+	"iter"
+)
+
+// ErrNoBatchResult is the error given to an item for which a batch function returned no Result.
+var ErrNoBatchResult = errors.New("batch returned no result for item")
+
+// Batch splits items into batches of at most size and calls f on each, returning one Result
+// per item in input order. f should return a Result for each item of its batch, in order;
+// items it returns no Result for get an Err with ErrNoBatchResult and extra Results are
+// ignored. A non-positive size puts every item in a single batch.
+func Batch[A, B any](items []A, size int, f func([]A) []Result[B]) []Result[B] {
+	results := make([]Result[B], 0, len(items))
+	for batch := range batches(items, size) {
+		out := f(batch)
+		for i := range batch {
+			if i < len(out) {
+				results = append(results, out[i])
+			} else {
+				results = append(results, Err[B](ErrNoBatchResult))
+			}
+		}
+	}
+	return results
+}
+
+// BatchKeyed is like Batch for bulk APIs that report outcomes by key rather than by
+// position: f returns Results keyed by the key of each item, and they are aligned with the
+// input using key. Items whose key is missing from f's map get an Err with ErrNoBatchResult.
+func BatchKeyed[K comparable, A, B any](items []A, size int, key func(A) K, f func([]A) map[K]Result[B]) []Result[B] {
+	results := make([]Result[B], 0, len(items))
+	for batch := range batches(items, size) {
+		out := f(batch)
+		for _, item := range batch {
+			r, ok := out[key(item)]
+			if !ok {
+				r = Err[B](ErrNoBatchResult)
+			}
+			results = append(results, r)
+		}
+	}
+	return results
+}
+
+// batches yields consecutive slices of items holding at most size elements each.
+func batches[A any](items []A, size int) iter.Seq[[]A] {
+	if size <= 0 {
+		size = max(len(items), 1)
+	}
+	return func(yield func([]A) bool) {
+		for start := 0; start < len(items); start += size {
+			end := min(start+size, len(items))
+			if !yield(items[start:end:end]) {
+				return
+			}
+		}
+	}
+}
@@ -0,0 +1,72 @@
+package jagain
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+)
+
+func TestBatch(t *testing.T) {
+	var sizes []int
+	double := func(batch []int) []Result[int] {
+		sizes = append(sizes, len(batch))
+		out := make([]Result[int], len(batch))
+		for i, n := range batch {
+			if n < 0 {
+				out[i] = Err[int](fmt.Errorf("negative: %d", n))
+			} else {
+				out[i] = Ok(n * 2)
+			}
+		}
+		return out
+	}
+
+	// Test results are aligned with the input across batches
+	results := Batch([]int{1, 2, -3, 4, 5}, 2, double)
+	if fmt.Sprint(results) != "[Ok(2) Ok(4) Err(negative: -3) Ok(8) Ok(10)]" {
+		t.Errorf("Unexpected results %v", results)
+	}
+	if fmt.Sprint(sizes) != "[2 2 1]" {
+		t.Errorf("Expected batches of [2 2 1], got %v", sizes)
+	}
+
+	// Test a non-positive size uses a single batch
+	sizes = nil
+	Batch([]int{1, 2, 3}, 0, double)
+	if fmt.Sprint(sizes) != "[3]" {
+		t.Errorf("Expected a single batch, got %v", sizes)
+	}
+
+	// Test missing results
+	results = Batch([]int{1, 2, 3}, 3, func(batch []int) []Result[int] {
+		return []Result[int]{Ok(1)}
+	})
+	if !errors.Is(results[2].UnwrapErr(), ErrNoBatchResult) {
+		t.Errorf("Expected ErrNoBatchResult, got %v", results[2])
+	}
+}
+
+func TestBatchKeyed(t *testing.T) {
+	type user struct {
+		ID   string
+		Name string
+	}
+	users := []user{{"u1", "Ann"}, {"u2", "Bob"}, {"u3", "Cy"}}
+
+	// Test results are aligned by key, whatever order f reports them in
+	results := BatchKeyed(users, 2, func(u user) string { return u.ID }, func(batch []user) map[string]Result[string] {
+		out := make(map[string]Result[string])
+		for i := len(batch) - 1; i >= 0; i-- {
+			if batch[i].ID != "u2" {
+				out[batch[i].ID] = Ok("sent to " + batch[i].Name)
+			}
+		}
+		return out
+	})
+	if results[0].UnwrapOr("") != "sent to Ann" || results[2].UnwrapOr("") != "sent to Cy" {
+		t.Errorf("Unexpected results %v", results)
+	}
+	if !errors.Is(results[1].UnwrapErr(), ErrNoBatchResult) {
+		t.Errorf("Expected ErrNoBatchResult for u2, got %v", results[1])
+	}
+}
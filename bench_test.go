@@ -0,0 +1,109 @@
+package jagain
+
+import (
+	"errors"
+	"testing"
+)
+
+type benchPoint struct {
+	X, Y, Z float64
+}
+
+var (
+	sinkOption Option[benchPoint]
+	sinkResult Result[benchPoint]
+	sinkPoint  benchPoint
+
+	errBench = errors.New("bench")
+)
+
+//go:noinline
+func lookupPoint(i int) Option[benchPoint] {
+	if i%4 == 0 {
+		return None[benchPoint]()
+	}
+	return Some(benchPoint{X: float64(i)})
+}
+
+func BenchmarkSome(b *testing.B) {
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		sinkOption = Some(benchPoint{X: float64(i)})
+	}
+}
+
+func BenchmarkOptionLookup(b *testing.B) {
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		sinkPoint = lookupPoint(i).UnwrapOr(benchPoint{})
+	}
+}
+
+func BenchmarkOptionMap(b *testing.B) {
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		sinkOption = lookupPoint(i).Map(func(p benchPoint) benchPoint {
+			p.Y = p.X * 2
+			return p
+		})
+	}
+}
+
+//go:noinline
+func loadPoint(i int) Result[benchPoint] {
+	if i%4 == 0 {
+		return Err[benchPoint](errBench)
+	}
+	return Ok(benchPoint{X: float64(i)})
+}
+
+func BenchmarkOk(b *testing.B) {
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		sinkResult = Ok(benchPoint{X: float64(i)})
+	}
+}
+
+func BenchmarkResultLoad(b *testing.B) {
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		sinkPoint = loadPoint(i).UnwrapOr(benchPoint{})
+	}
+}
+
+func BenchmarkResultFlatMap(b *testing.B) {
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		sinkResult = loadPoint(i).FlatMap(func(p benchPoint) Result[benchPoint] {
+			p.Y = p.X * 2
+			return Ok(p)
+		})
+	}
+}
+
+func TestOptionAllocs(t *testing.T) {
+	if debugBuild {
+		t.Skip("debug builds allocate to record origins")
+	}
+	allocs := testing.AllocsPerRun(100, func() {
+		sinkOption = lookupPoint(1).Map(func(p benchPoint) benchPoint { return p })
+		sinkPoint = sinkOption.UnwrapOr(benchPoint{})
+	})
+	if allocs != 0 {
+		t.Errorf("Expected creating and using an Option not to allocate, got %v allocations", allocs)
+	}
+}
+
+func TestResultAllocs(t *testing.T) {
+	if debugBuild {
+		t.Skip("debug builds allocate to record origins")
+	}
+	allocs := testing.AllocsPerRun(100, func() {
+		sinkResult = loadPoint(1).Map(func(p benchPoint) benchPoint { return p })
+		sinkResult = loadPoint(0)
+		sinkPoint = sinkResult.UnwrapOr(benchPoint{})
+	})
+	if allocs != 0 {
+		t.Errorf("Expected creating and using a Result not to allocate, got %v allocations", allocs)
+	}
+}
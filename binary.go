@@ -0,0 +1,108 @@
+package jagain
+
+import (
+	"encoding"
+	"encoding/binary"
+	"encoding/json"
+	"errors"
+	"reflect"
+)
+
+// Binary encodings always start with a tag byte telling None from Some, so that Some of
+// an empty value does not read back as None. The tag makes the encoding specific to
+// jagain: other clients reading the same redis key see it in front of the value, and
+// UnmarshalBinary rejects data that does not start with one.
+const (
+	binaryNone byte = 0
+	binarySome byte = 1
+)
+
+var (
+	errBinaryTag    = errors.New("binary data does not start with a None or Some tag")
+	errBinaryLength = errors.New("binary data does not match its length prefix")
+)
+
+// MarshalBinary implements the encoding.BinaryMarshaler interface, which is how go-redis
+// writes values. None is encoded as a single zero byte. Some is a one byte followed by
+// T's own BinaryMarshaler encoding when it has one, plain text for strings, numbers,
+// booleans and encoding.TextMarshaler types, and for anything else JSON prefixed with
+// its length as a uvarint, so truncated JSON is reported instead of misread.
+func (o Option[T]) MarshalBinary() ([]byte, error) {
+	if !o.valid {
+		return []byte{binaryNone}, nil
+	}
+
+	var data []byte
+	var err error
+	if m, ok := any(&o.value).(encoding.BinaryMarshaler); ok {
+		data, err = m.MarshalBinary()
+	} else if isTextual(reflect.TypeFor[T]()) {
+		data, err = marshalText(o.value)
+	} else {
+		data, err = json.Marshal(o.value)
+		data = append(binary.AppendUvarint(nil, uint64(len(data))), data...)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return append([]byte{binarySome}, data...), nil
+}
+
+// UnmarshalBinary implements the encoding.BinaryUnmarshaler interface, which is how
+// go-redis scans values. It accepts only data written by MarshalBinary. Truncation is
+// detected for the JSON fallback alone; T's own BinaryUnmarshaler and text decoding see
+// whatever follows the tag.
+func (o *Option[T]) UnmarshalBinary(data []byte) error {
+	switch {
+	case len(data) == 1 && data[0] == binaryNone:
+		*o = None[T]()
+		return nil
+	case len(data) == 0 || data[0] != binarySome:
+		return errBinaryTag
+	}
+	data = data[1:]
+
+	var value T
+	if u, ok := any(&value).(encoding.BinaryUnmarshaler); ok {
+		if err := u.UnmarshalBinary(data); err != nil {
+			return err
+		}
+	} else if isTextual(reflect.TypeFor[T]()) {
+		if err := unmarshalText(data, &value); err != nil {
+			return err
+		}
+	} else {
+		n, size := binary.Uvarint(data)
+		if size <= 0 || uint64(len(data)-size) != n {
+			return errBinaryLength
+		}
+		if err := json.Unmarshal(data[size:], &value); err != nil {
+			return err
+		}
+	}
+
+	*o = Some(value)
+	return nil
+}
+
+// ScanRedis decodes a hash field written by MarshalBinary. go-redis calls it when
+// scanning a hash into a struct, where it would otherwise use UnmarshalText.
+func (o *Option[T]) ScanRedis(s string) error {
+	return o.UnmarshalBinary([]byte(s))
+}
+
+// isTextual reports whether values of t round-trip through marshalText and unmarshalText.
+func isTextual(t reflect.Type) bool {
+	if t.Implements(reflect.TypeFor[encoding.TextMarshaler]()) &&
+		reflect.PointerTo(t).Implements(reflect.TypeFor[encoding.TextUnmarshaler]()) {
+		return true
+	}
+	switch t.Kind() {
+	case reflect.String, reflect.Bool,
+		reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr,
+		reflect.Float32, reflect.Float64:
+		return true
+	}
+	return false
+}
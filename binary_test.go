@@ -0,0 +1,80 @@
+package jagain
+
+import (
+	"bytes"
+	"net/url"
+	"testing"
+)
+
+// rawBlob is a BinaryMarshaler whose encoding is its bytes as they are.
+type rawBlob []byte
+
+func (b rawBlob) MarshalBinary() ([]byte, error) { return b, nil }
+
+func (b *rawBlob) UnmarshalBinary(data []byte) error {
+	*b = bytes.Clone(data)
+	return nil
+}
+
+func TestOptionBinary(t *testing.T) {
+	// Test MarshalBinary
+	data, err := Some(42).MarshalBinary()
+	if err != nil || string(data) != "\x0142" {
+		t.Errorf("Expected Some(42) to marshal as \"\\x0142\", got %q (%v)", data, err)
+	}
+	data, err = None[int]().MarshalBinary()
+	if err != nil || string(data) != "\x00" {
+		t.Errorf("Expected None to marshal as a zero byte, got %q (%v)", data, err)
+	}
+	data, err = Some(map[string]int{"a": 1}).MarshalBinary()
+	if err != nil || string(data) != "\x01\x07{\"a\":1}" {
+		t.Errorf("Expected a map to marshal as length-prefixed JSON, got %q (%v)", data, err)
+	}
+	data, err = Some(url.URL{Scheme: "https", Host: "example.com"}).MarshalBinary()
+	if err != nil || string(data) != "\x01https://example.com" {
+		t.Errorf("Expected inner BinaryMarshaler to be used, got %q (%v)", data, err)
+	}
+
+	// Test UnmarshalBinary
+	var m Option[map[string]int]
+	if err := m.UnmarshalBinary([]byte("\x01\x07{\"b\":2}")); err != nil || m.Unwrap()["b"] != 2 {
+		t.Errorf("Expected JSON to unmarshal as Some, got %v (%v)", m, err)
+	}
+	if err := m.UnmarshalBinary([]byte("\x01\x07{\"b\":")); err == nil {
+		t.Errorf("Expected truncated JSON to fail")
+	}
+	var u Option[url.URL]
+	if err := u.UnmarshalBinary([]byte("\x01https://example.com/x")); err != nil || u.Unwrap().Path != "/x" {
+		t.Errorf("Expected inner BinaryUnmarshaler to be used, got %v (%v)", u, err)
+	}
+	if err := u.UnmarshalBinary([]byte{0}); err != nil || !u.IsNone() {
+		t.Errorf("Expected a zero byte to unmarshal as None, got %v (%v)", u, err)
+	}
+	if err := u.UnmarshalBinary(nil); err == nil {
+		t.Errorf("Expected empty data to fail, got %v", u)
+	}
+	if err := m.UnmarshalBinary([]byte(`{"c":3}`)); err == nil {
+		t.Errorf("Expected untagged JSON to fail, got %v", m)
+	}
+
+	// Test empty values round-trip as Some
+	var s Option[string]
+	data, _ = Some("").MarshalBinary()
+	if err := s.UnmarshalBinary(data); err != nil || !s.IsSome() || s.Unwrap() != "" {
+		t.Errorf("Expected Some(\"\") to round-trip, got %v (%v)", s, err)
+	}
+	var b Option[[]byte]
+	data, _ = Some([]byte{}).MarshalBinary()
+	if err := b.UnmarshalBinary(data); err != nil || !b.IsSome() || len(b.Unwrap()) != 0 {
+		t.Errorf("Expected Some([]byte{}) to round-trip, got %v (%v)", b, err)
+	}
+
+	// Test BinaryMarshaler payloads that start with a tag value
+	for _, payload := range []rawBlob{{0}, {1}, {0, 1, 2}, {1, 0}} {
+		var r Option[rawBlob]
+		data, _ = Some(payload).MarshalBinary()
+		if err := r.UnmarshalBinary(data); err != nil || !r.IsSome() || !bytes.Equal(r.Unwrap(), payload) {
+			t.Errorf("Expected Some(%v) to round-trip, got %v (%v)", payload, r, err)
+		}
+	}
+}
@@ -0,0 +1,137 @@
+package jagain
+
+import (
+	"fmt"
+	"io"
+	"mime"
+	"net/http"
+	"reflect"
+	"sync"
+)
+
+var (
+	validatorsMu sync.RWMutex
+	validators   = map[reflect.Type][]func(any) error{}
+)
+
+// MaxBodyBytes is the largest JSON body Bind reads; larger bodies fail with an
+// *http.MaxBytesError, which HTTPStatus maps to 413.
+// It may be changed at startup to raise or lower the limit for every Bind.
+var MaxBodyBytes int64 = 1 << 20
+
+// RegisterValidator adds a validator that Bind runs on every decoded T.
+// Validators run in registration order and all of their errors are reported.
+func RegisterValidator[T any](f func(T) error) {
+	validatorsMu.Lock()
+	defer validatorsMu.Unlock()
+	t := reflect.TypeFor[T]()
+	validators[t] = append(validators[t], func(v any) error {
+		return f(v.(T))
+	})
+}
+
+// Bind decodes a request into T and validates it.
+// JSON bodies are decoded with DecodeStrict, form bodies and bodiless requests with
+// DecodeQuery, so Option fields are None when their key or parameter is absent.
+// Fields tagged `path:"name"` are then filled from the request's path wildcards, as
+// recorded by http.ServeMux; tag them `json:"-"` as well when the body is JSON.
+// Decoding problems and validator errors are reported together as ValidationErrors; a
+// validator error that carries no FieldError is reported without a path.
+func Bind[T any](r *http.Request) Result[T] {
+	return bind[T](r, r.PathValue)
+}
+
+// BindParams is like Bind but takes path parameters from params, for routers that do not
+// record them on the request.
+func BindParams[T any](r *http.Request, params map[string]string) Result[T] {
+	return bind[T](r, func(name string) string { return params[name] })
+}
+
+func bind[T any](r *http.Request, pathValue func(string) string) Result[T] {
+	decoded := decodeRequest[T](r)
+	if !decoded.valid {
+		return decoded
+	}
+	if errs := decodePath(&decoded.value, pathValue); len(errs) > 0 {
+		return Err[T](errs)
+	}
+
+	validatorsMu.RLock()
+	checks := validators[reflect.TypeFor[T]()]
+	validatorsMu.RUnlock()
+
+	var errs ValidationErrors
+	for _, check := range checks {
+		if err := check(decoded.value); err != nil {
+			errs = append(errs, ValidationErrorsOf(err)...)
+		}
+	}
+	if len(errs) > 0 {
+		return Err[T](errs)
+	}
+	return decoded
+}
+
+func decodeRequest[T any](r *http.Request) Result[T] {
+	if r.Body == nil || r.Body == http.NoBody {
+		return DecodeQuery[T](r.URL.Query())
+	}
+
+	mediaType, _, _ := mime.ParseMediaType(r.Header.Get("Content-Type"))
+	switch mediaType {
+	case "application/json":
+		body, err := io.ReadAll(http.MaxBytesReader(nil, r.Body, MaxBodyBytes))
+		if err != nil {
+			return Err[T](fmt.Errorf("bind: %w", err))
+		}
+		return DecodeStrict[T](body)
+	case "application/x-www-form-urlencoded":
+		if err := r.ParseForm(); err != nil {
+			return Err[T](&FieldError{Err: err})
+		}
+		return DecodeQuery[T](r.Form)
+	case "multipart/form-data":
+		if err := r.ParseMultipartForm(32 << 20); err != nil {
+			return Err[T](&FieldError{Err: err})
+		}
+		return DecodeQuery[T](r.Form)
+	}
+	return Err[T](&httpError{
+		code: http.StatusUnsupportedMediaType,
+		err:  fmt.Errorf("bind: unsupported content type %q", mediaType),
+	})
+}
+
+// decodePath fills the fields of *item tagged with "path" from pathValue, leaving those
+// whose parameter is empty untouched.
+func decodePath[T any](item *T, pathValue func(string) string) ValidationErrors {
+	v := reflect.ValueOf(item).Elem()
+	if v.Kind() != reflect.Struct {
+		return nil
+	}
+
+	var errs ValidationErrors
+	for _, f := range taggedFields(v.Type(), "path", nil, nil) {
+		if _, tagged := v.Type().FieldByIndex(f.index).Tag.Lookup("path"); !tagged {
+			continue
+		}
+		param := pathValue(f.name)
+		if param == "" {
+			continue
+		}
+		if err := decodeParam(v.FieldByIndex(f.index), []string{param}); err != nil {
+			errs = append(errs, &FieldError{Path: f.name, Err: err})
+		}
+	}
+	return errs
+}
+
+// httpError attaches an HTTP status code to an error.
+type httpError struct {
+	code int
+	err  error
+}
+
+func (e *httpError) Error() string   { return e.err.Error() }
+func (e *httpError) Unwrap() error   { return e.err }
+func (e *httpError) StatusCode() int { return e.code }
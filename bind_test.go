@@ -0,0 +1,112 @@
+package jagain
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+type signup struct {
+	Email    string         `json:"email" query:"email"`
+	Password string         `json:"password" query:"password"`
+	Referrer Option[string] `json:"referrer" query:"referrer"`
+}
+
+func TestBind(t *testing.T) {
+	RegisterValidator(func(s signup) error {
+		if !strings.Contains(s.Email, "@") {
+			return &FieldError{Path: "email", Err: errors.New("must be an email address")}
+		}
+		return nil
+	})
+	RegisterValidator(func(s signup) error {
+		if len(s.Password) < 8 {
+			return errors.New("password is too short")
+		}
+		return nil
+	})
+
+	// Test binding a JSON body
+	req := httptest.NewRequest(http.MethodPost, "/", strings.NewReader(`{"email":"jane@example.com","password":"hunter2hunter2"}`))
+	req.Header.Set("Content-Type", "application/json; charset=utf-8")
+	bound := Bind[signup](req)
+	if bound.IsErr() {
+		t.Fatalf("Failed to bind JSON: %v", bound.UnwrapErr())
+	}
+	if !bound.Unwrap().Referrer.IsNone() {
+		t.Errorf("Expected absent referrer to be None, got %v", bound.Unwrap().Referrer)
+	}
+
+	// Test binding a form body
+	req = httptest.NewRequest(http.MethodPost, "/", strings.NewReader("email=jane@example.com&password=hunter2hunter2&referrer=ad"))
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	bound = Bind[signup](req)
+	if bound.IsErr() || bound.Unwrap().Referrer.UnwrapOr("") != "ad" {
+		t.Errorf("Expected form to bind, got %v", bound)
+	}
+
+	// Test binding query parameters and collecting validator errors
+	bound = Bind[signup](httptest.NewRequest(http.MethodGet, "/?email=jane&password=short", nil))
+	if !bound.IsErr() {
+		t.Fatalf("Expected validation to fail")
+	}
+	msg := bound.UnwrapErr().Error()
+	if !strings.Contains(msg, "email: must be an email address") || !strings.Contains(msg, "password is too short") {
+		t.Errorf("Expected both validator errors, got:\n%s", msg)
+	}
+	if HTTPStatus(bound.UnwrapErr()) != http.StatusBadRequest {
+		t.Errorf("Expected validation errors to map to 400, got %d", HTTPStatus(bound.UnwrapErr()))
+	}
+
+	// Test unsupported content types
+	req = httptest.NewRequest(http.MethodPost, "/", strings.NewReader("<signup/>"))
+	req.Header.Set("Content-Type", "application/xml")
+	bound = Bind[signup](req)
+	if !bound.IsErr() || HTTPStatus(bound.UnwrapErr()) != http.StatusUnsupportedMediaType {
+		t.Errorf("Expected unsupported content type to map to 415, got %v", bound)
+	}
+
+	// Test oversized JSON bodies
+	defer func(limit int64) { MaxBodyBytes = limit }(MaxBodyBytes)
+	MaxBodyBytes = 16
+	req = httptest.NewRequest(http.MethodPost, "/", strings.NewReader(`{"email":"jane@example.com","password":"hunter2hunter2"}`))
+	req.Header.Set("Content-Type", "application/json")
+	bound = Bind[signup](req)
+	if !bound.IsErr() || HTTPStatus(bound.UnwrapErr()) != http.StatusRequestEntityTooLarge {
+		t.Errorf("Expected an oversized body to map to 413, got %v", bound)
+	}
+}
+
+type updateUser struct {
+	ID   int            `path:"id" json:"-" query:"-"`
+	Name Option[string] `json:"name" query:"name"`
+}
+
+func TestBindPath(t *testing.T) {
+	// Test path wildcards recorded by http.ServeMux
+	mux := http.NewServeMux()
+	var bound Result[updateUser]
+	mux.HandleFunc("PATCH /users/{id}", func(w http.ResponseWriter, r *http.Request) {
+		bound = Bind[updateUser](r)
+	})
+	req := httptest.NewRequest(http.MethodPatch, "/users/42", strings.NewReader(`{"name":"Jane"}`))
+	req.Header.Set("Content-Type", "application/json")
+	mux.ServeHTTP(httptest.NewRecorder(), req)
+	if bound.IsErr() || bound.Unwrap().ID != 42 || bound.Unwrap().Name.UnwrapOr("") != "Jane" {
+		t.Errorf("Expected {42 Some(Jane)}, got %v", bound)
+	}
+
+	// Test path parameters supplied by another router
+	bound = BindParams[updateUser](httptest.NewRequest(http.MethodPatch, "/users/7", nil), map[string]string{"id": "7"})
+	if bound.IsErr() || bound.Unwrap().ID != 7 || !bound.Unwrap().Name.IsNone() {
+		t.Errorf("Expected {7 None}, got %v", bound)
+	}
+
+	// Test invalid path parameters
+	bound = BindParams[updateUser](httptest.NewRequest(http.MethodPatch, "/users/x", nil), map[string]string{"id": "x"})
+	if !bound.IsErr() || !strings.HasPrefix(bound.UnwrapErr().Error(), "id: ") {
+		t.Errorf("Expected an id error, got %v", bound)
+	}
+}
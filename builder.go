@@ -0,0 +1,56 @@
+package jagain
+
+// FieldResult is implemented by every Result type, so that Results of different types can
+// be passed to Builder.Field.
+type FieldResult interface {
+	fieldErr() error
+}
+
+func (r Result[T]) fieldErr() error {
+	if r.valid {
+		return nil
+	}
+	return r.err
+}
+
+// Builder assembles a value of type T from independently parsed fields, reporting every
+// failed field at once instead of stopping at the first, in the style of ValidationErrors:
+//
+//	user := jagain.NewBuilder[User]().
+//		Field("email", emailR).
+//		Field("age", ageR).
+//		Build(func() User {
+//			return User{Email: emailR.Unwrap(), Age: ageR.Unwrap()}
+//		})
+type Builder[T any] struct {
+	errs ValidationErrors
+}
+
+// NewBuilder creates an empty Builder for T.
+func NewBuilder[T any]() *Builder[T] {
+	return &Builder[T]{}
+}
+
+// Field records the outcome of parsing the named field. If r is an Err its error is kept
+// under name; FieldErrors in it, such as those from a nested Builder, keep their paths
+// with name prepended.
+func (b *Builder[T]) Field(name string, r FieldResult) *Builder[T] {
+	for _, fe := range ValidationErrorsOf(r.fieldErr()) {
+		path := name
+		if fe.Path != "" {
+			path = joinPath(name, fe.Path)
+		}
+		b.errs = append(b.errs, &FieldError{Path: path, Err: fe.Err})
+	}
+	return b
+}
+
+// Build calls construct if every field is Ok, so construct may unwrap the field Results
+// freely. Otherwise it returns an Err holding ValidationErrors keyed by field name, in the
+// order the fields were added.
+func (b *Builder[T]) Build(construct func() T) Result[T] {
+	if len(b.errs) > 0 {
+		return Err[T](b.errs)
+	}
+	return Ok(construct())
+}
@@ -0,0 +1,60 @@
+package jagain
+
+import (
+	"errors"
+	"strconv"
+	"testing"
+)
+
+func TestBuilder(t *testing.T) {
+	type user struct {
+		Email string
+		Age   int
+	}
+	parseAge := func(s string) Result[int] {
+		n, err := strconv.Atoi(s)
+		if err != nil {
+			return Err[int](errors.New("not a number"))
+		}
+		return Ok(n)
+	}
+
+	// Test all fields Ok
+	emailR, ageR := Ok("jane@example.com"), parseAge("42")
+	r := NewBuilder[user]().
+		Field("email", emailR).
+		Field("age", ageR).
+		Build(func() user { return user{Email: emailR.Unwrap(), Age: ageR.Unwrap()} })
+	if got := r.UnwrapOr(user{}); got != (user{"jane@example.com", 42}) {
+		t.Errorf("Expected the constructed user, got %v", r)
+	}
+
+	// Test every failed field is reported and construct is not called
+	called := false
+	r = NewBuilder[user]().
+		Field("email", Err[string](errors.New("is required"))).
+		Field("age", parseAge("old")).
+		Build(func() user { called = true; return user{} })
+	if called {
+		t.Errorf("Expected construct not to be called")
+	}
+	errs := ValidationErrorsOf(r.UnwrapErr())
+	if len(errs) != 2 || errs[0].Path != "email" || errs[1].Path != "age" {
+		t.Fatalf("Expected errors for email and age, got %v", errs)
+	}
+	if errs[1].Error() != "age: not a number" {
+		t.Errorf("Expected \"age: not a number\", got %q", errs[1].Error())
+	}
+
+	// Test nested builders keep their paths
+	address := NewBuilder[string]().
+		Field("city", Err[string](errors.New("is required"))).
+		Build(func() string { return "" })
+	r = NewBuilder[user]().
+		Field("address", address).
+		Build(func() user { return user{} })
+	errs = ValidationErrorsOf(r.UnwrapErr())
+	if len(errs) != 1 || errs[0].Path != "address.city" {
+		t.Errorf("Expected a single error at address.city, got %v", errs)
+	}
+}
@@ -0,0 +1,99 @@
+package jagain
+
+import (
+	"container/list"
+	"sync"
+)
+
+// Cache stores values by key. Get reports a missing key as None rather than a zero value
+// and a found flag.
+type Cache[K comparable, V any] interface {
+	// Get returns the value cached for key, or None.
+	Get(key K) Option[V]
+	// Put caches value for key.
+	Put(key K, value V)
+	// GetOrLoad returns the value cached for key, or loads it with load and caches it if
+	// load returns Ok. An Err is returned without being cached.
+	GetOrLoad(key K, load func(K) Result[V]) Result[V]
+}
+
+// LRU is a size-bounded Cache that evicts the least recently used entry when full.
+// It is safe for concurrent use.
+type LRU[K comparable, V any] struct {
+	mu       sync.Mutex
+	capacity int
+	entries  map[K]*list.Element
+	order    *list.List
+}
+
+type lruEntry[K comparable, V any] struct {
+	key   K
+	value V
+}
+
+// NewLRU creates an LRU holding at most capacity entries. A non-positive capacity is treated as one.
+func NewLRU[K comparable, V any](capacity int) *LRU[K, V] {
+	if capacity <= 0 {
+		capacity = 1
+	}
+	return &LRU[K, V]{capacity: capacity, entries: make(map[K]*list.Element), order: list.New()}
+}
+
+// Get returns the value cached for key and marks it as recently used, or returns None.
+func (c *LRU[K, V]) Get(key K) Option[V] {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	e, ok := c.entries[key]
+	if !ok {
+		return None[V]()
+	}
+	c.order.MoveToFront(e)
+	return Some(e.Value.(*lruEntry[K, V]).value)
+}
+
+// Put caches value for key, evicting the least recently used entry if the LRU is full.
+func (c *LRU[K, V]) Put(key K, value V) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if e, ok := c.entries[key]; ok {
+		e.Value.(*lruEntry[K, V]).value = value
+		c.order.MoveToFront(e)
+		return
+	}
+	c.entries[key] = c.order.PushFront(&lruEntry[K, V]{key: key, value: value})
+	if c.order.Len() > c.capacity {
+		oldest := c.order.Remove(c.order.Back()).(*lruEntry[K, V])
+		delete(c.entries, oldest.key)
+	}
+}
+
+// GetOrLoad returns the value cached for key, or loads it with load and caches it if load
+// returns Ok. The lock is not held while load runs, so concurrent misses for the same key
+// may each call load; wrap load with a SharedLoader when that matters.
+func (c *LRU[K, V]) GetOrLoad(key K, load func(K) Result[V]) Result[V] {
+	if v := c.Get(key); v.valid {
+		return Ok(v.value)
+	}
+	r := load(key)
+	if r.valid {
+		c.Put(key, r.value)
+	}
+	return r
+}
+
+// Delete removes key from the LRU.
+func (c *LRU[K, V]) Delete(key K) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if e, ok := c.entries[key]; ok {
+		c.order.Remove(e)
+		delete(c.entries, key)
+	}
+}
+
+// Len returns the number of cached entries.
+func (c *LRU[K, V]) Len() int {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.order.Len()
+}
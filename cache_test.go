@@ -0,0 +1,65 @@
+package jagain
+
+import (
+	"errors"
+	"testing"
+)
+
+var _ Cache[string, int] = (*LRU[string, int])(nil)
+
+func TestLRU(t *testing.T) {
+	c := NewLRU[string, int](2)
+
+	// Test missing keys are None
+	if got := c.Get("a"); got.IsSome() {
+		t.Errorf("Expected None, got %v", got)
+	}
+
+	// Test the least recently used entry is evicted
+	c.Put("a", 1)
+	c.Put("b", 2)
+	c.Get("a")
+	c.Put("c", 3)
+	if got := c.Get("b"); got.IsSome() {
+		t.Errorf("Expected b to be evicted, got %v", got)
+	}
+	if c.Get("a").UnwrapOr(0) != 1 || c.Get("c").UnwrapOr(0) != 3 {
+		t.Errorf("Expected a and c to be cached")
+	}
+
+	// Test Put replaces an existing value without growing
+	c.Put("a", 10)
+	if c.Get("a").UnwrapOr(0) != 10 || c.Len() != 2 {
+		t.Errorf("Expected a to be replaced, got %v with %d entries", c.Get("a"), c.Len())
+	}
+
+	// Test Delete
+	c.Delete("a")
+	if c.Get("a").IsSome() || c.Len() != 1 {
+		t.Errorf("Expected a to be deleted")
+	}
+}
+
+func TestLRUGetOrLoad(t *testing.T) {
+	c := NewLRU[string, int](2)
+	calls := 0
+	load := func(key string) Result[int] {
+		calls++
+		if key == "bad" {
+			return Err[int](errors.New("not found"))
+		}
+		return Ok(len(key))
+	}
+
+	// Test Ok results are cached
+	c.GetOrLoad("abc", load)
+	if r := c.GetOrLoad("abc", load); r.UnwrapOr(0) != 3 || calls != 1 {
+		t.Errorf("Expected Ok(3) from one load, got %v after %d loads", r, calls)
+	}
+
+	// Test Err results are not cached
+	c.GetOrLoad("bad", load)
+	if r := c.GetOrLoad("bad", load); !r.IsErr() || calls != 3 {
+		t.Errorf("Expected Err to be reloaded, got %v after %d loads", r, calls)
+	}
+}
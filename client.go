@@ -0,0 +1,90 @@
+package jagain
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+)
+
+// maxErrorBody bounds how much of a non-2xx response body DoJSON keeps.
+const maxErrorBody = 64 << 10
+
+// TransportError reports that an HTTP request could not be completed.
+type TransportError struct {
+	Err error
+}
+
+// Error implements the error interface.
+func (e *TransportError) Error() string {
+	return "http transport: " + e.Err.Error()
+}
+
+// Unwrap returns the underlying error.
+func (e *TransportError) Unwrap() error {
+	return e.Err
+}
+
+// StatusError reports a response with a non-2xx status code.
+// Body holds the start of the response body, which often explains the failure.
+type StatusError struct {
+	StatusCode int
+	Status     string
+	Body       []byte
+}
+
+// Error implements the error interface.
+func (e *StatusError) Error() string {
+	return "http status: " + e.Status
+}
+
+// DecodeError reports that a response body could not be decoded.
+type DecodeError struct {
+	Err error
+}
+
+// Error implements the error interface.
+func (e *DecodeError) Error() string {
+	return "http decode: " + e.Err.Error()
+}
+
+// Unwrap returns the underlying error.
+func (e *DecodeError) Unwrap() error {
+	return e.Err
+}
+
+// DoJSON sends req with ctx using client, or http.DefaultClient when client is nil, and
+// decodes a 2xx JSON response body into T. A 204 No Content response yields the zero T.
+// Failures are reported as *TransportError, *StatusError or *DecodeError.
+func DoJSON[T any](ctx context.Context, client *http.Client, req *http.Request) Result[T] {
+	if client == nil {
+		client = http.DefaultClient
+	}
+	if req.Header.Get("Accept") == "" {
+		req = req.Clone(ctx)
+		req.Header.Set("Accept", "application/json")
+	} else {
+		req = req.WithContext(ctx)
+	}
+
+	resp, err := client.Do(req)
+	if err != nil {
+		return Err[T](&TransportError{Err: err})
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		body, _ := io.ReadAll(io.LimitReader(resp.Body, maxErrorBody))
+		return Err[T](&StatusError{StatusCode: resp.StatusCode, Status: resp.Status, Body: body})
+	}
+
+	var value T
+	if resp.StatusCode == http.StatusNoContent {
+		return Ok(value)
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&value); err != nil {
+		return Err[T](&DecodeError{Err: fmt.Errorf("%s %s: %w", req.Method, req.URL, err)})
+	}
+	return Ok(value)
+}
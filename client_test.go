@@ -0,0 +1,66 @@
+package jagain
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestDoJSON(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/user":
+			if r.Header.Get("Accept") != "application/json" {
+				t.Errorf("Expected JSON Accept header, got %q", r.Header.Get("Accept"))
+			}
+			w.Write([]byte(`{"name":"Jane","email":null}`))
+		case "/empty":
+			w.WriteHeader(http.StatusNoContent)
+		case "/broken":
+			w.Write([]byte(`{"name":`))
+		default:
+			http.Error(w, "no such user", http.StatusNotFound)
+		}
+	}))
+	defer srv.Close()
+
+	type user struct {
+		Name  string         `json:"name"`
+		Email Option[string] `json:"email"`
+	}
+	get := func(path string) Result[user] {
+		req, _ := http.NewRequest(http.MethodGet, srv.URL+path, nil)
+		return DoJSON[user](context.Background(), srv.Client(), req)
+	}
+
+	// Test a successful request
+	if u := get("/user"); u.IsErr() || u.Unwrap().Name != "Jane" || !u.Unwrap().Email.IsNone() {
+		t.Errorf("Expected user to decode, got %v", u)
+	}
+	if u := get("/empty"); u.IsErr() || u.Unwrap().Name != "" {
+		t.Errorf("Expected 204 to yield the zero value, got %v", u)
+	}
+
+	// Test a status error
+	var statusErr *StatusError
+	if u := get("/missing"); !errors.As(u.UnwrapErr(), &statusErr) || statusErr.StatusCode != http.StatusNotFound || string(statusErr.Body) != "no such user\n" {
+		t.Errorf("Expected a 404 StatusError, got %v", u)
+	}
+
+	// Test a decode error
+	var decodeErr *DecodeError
+	if u := get("/broken"); !errors.As(u.UnwrapErr(), &decodeErr) {
+		t.Errorf("Expected a DecodeError, got %v", u)
+	}
+
+	// Test a transport error
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	req, _ := http.NewRequest(http.MethodGet, srv.URL+"/user", nil)
+	var transportErr *TransportError
+	if u := DoJSON[user](ctx, srv.Client(), req); !errors.As(u.UnwrapErr(), &transportErr) || !errors.Is(u.UnwrapErr(), context.Canceled) {
+		t.Errorf("Expected a TransportError wrapping context.Canceled, got %v", u)
+	}
+}
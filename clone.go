@@ -0,0 +1,48 @@
+package jagain
+
+// Cloner is implemented by types that know how to deep-copy themselves.
+// Clone and the Clone methods on Option and Result use it when T implements it.
+type Cloner[T any] interface {
+	Clone() T
+}
+
+// cloneValue copies v using its Cloner implementation, if any.
+// Types without one are copied by assignment, which is shallow.
+func cloneValue[T any](v T) T {
+	if c, ok := any(v).(Cloner[T]); ok {
+		return c.Clone()
+	}
+	if c, ok := any(&v).(Cloner[T]); ok {
+		return c.Clone()
+	}
+	return v
+}
+
+// Clone returns a copy of the Option. If T implements Cloner the value is deep-copied
+// with it; otherwise the copy is shallow, so slices, maps and pointers inside the value
+// are still shared.
+func (o Option[T]) Clone() Option[T] {
+	return o.CloneWith(cloneValue[T])
+}
+
+// CloneWith returns a copy of the Option with its value copied by f.
+func (o Option[T]) CloneWith(f func(T) T) Option[T] {
+	if o.valid {
+		o.value = f(o.value)
+	}
+	return o
+}
+
+// Clone returns a copy of the Result. If T implements Cloner the success value is
+// deep-copied with it; otherwise the copy is shallow. The error is shared.
+func (r Result[T]) Clone() Result[T] {
+	return r.CloneWith(cloneValue[T])
+}
+
+// CloneWith returns a copy of the Result with its success value copied by f.
+func (r Result[T]) CloneWith(f func(T) T) Result[T] {
+	if r.valid {
+		r.value = f(r.value)
+	}
+	return r
+}
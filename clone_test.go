@@ -0,0 +1,63 @@
+package jagain
+
+import (
+	"errors"
+	"slices"
+	"testing"
+)
+
+type cloneTags []string
+
+func (c cloneTags) Clone() cloneTags {
+	return slices.Clone(c)
+}
+
+type clonePtr struct {
+	tags []string
+}
+
+func (c *clonePtr) Clone() clonePtr {
+	return clonePtr{tags: slices.Clone(c.tags)}
+}
+
+func TestClone(t *testing.T) {
+	// Test Cloner with a value receiver
+	o := Some(cloneTags{"a", "b"})
+	c := o.Clone()
+	c.Unwrap()[0] = "x"
+	if o.Unwrap()[0] != "a" {
+		t.Errorf("Expected Clone to deep-copy, got %v", o)
+	}
+
+	// Test Cloner with a pointer receiver
+	p := Ok(clonePtr{tags: []string{"a"}})
+	cp := p.Clone()
+	cp.Unwrap().tags[0] = "x"
+	if p.Unwrap().tags[0] != "a" {
+		t.Errorf("Expected Clone to use the pointer Cloner, got %v", p)
+	}
+
+	// Test shallow copy without a Cloner
+	s := Some([]int{1})
+	s.Clone().Unwrap()[0] = 2
+	if s.Unwrap()[0] != 2 {
+		t.Errorf("Expected Clone without a Cloner to be shallow")
+	}
+
+	// Test CloneWith
+	s = Some([]int{1})
+	sc := s.CloneWith(slices.Clone[[]int])
+	sc.Unwrap()[0] = 2
+	if s.Unwrap()[0] != 1 {
+		t.Errorf("Expected CloneWith to use f, got %v", s)
+	}
+
+	// Test None and Err are unchanged
+	if !None[cloneTags]().Clone().IsNone() {
+		t.Errorf("Expected Clone of None to be None")
+	}
+	errTest := errors.New("bad")
+	if Err[clonePtr](errTest).Clone().UnwrapErr() != errTest {
+		t.Errorf("Expected Clone of Err to keep the error")
+	}
+}
@@ -0,0 +1,282 @@
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"go/format"
+	"go/types"
+	"maps"
+	"reflect"
+	"slices"
+	"strings"
+
+	"golang.org/x/tools/go/packages"
+)
+
+const jagainPath = "github.com/dendianugerah/jagain"
+
+// nullTypes maps database/sql Null types to the type their Option holds and the jagain
+// functions converting to and from them.
+var nullTypes = map[string]struct{ elem, from, to string }{
+	"NullString":  {"string", "FromNullString", "ToNullString"},
+	"NullInt64":   {"int64", "FromNullInt64", "ToNullInt64"},
+	"NullInt32":   {"int32", "FromNullInt32", "ToNullInt32"},
+	"NullInt16":   {"int16", "FromNullInt16", "ToNullInt16"},
+	"NullByte":    {"byte", "FromNullByte", "ToNullByte"},
+	"NullFloat64": {"float64", "FromNullFloat64", "ToNullFloat64"},
+	"NullBool":    {"bool", "FromNullBool", "ToNullBool"},
+	"NullTime":    {"time.Time", "FromNullTime", "ToNullTime"},
+}
+
+// generator accumulates generated code and the imports it needs.
+type generator struct {
+	pkg     *types.Package
+	imports map[string]string
+	buf     bytes.Buffer
+}
+
+// field describes how one source field maps to the generated struct.
+type field struct {
+	name     string
+	typ      string
+	tag      string
+	embedded bool
+	from     string // converts src.<name> to the generated field; %s is the value
+	to       string // converts the generated field back; %s is the value
+}
+
+// loadStruct loads the package in dir and finds the named struct type.
+func loadStruct(dir, typeName string) (*types.Package, *types.Struct, error) {
+	cfg := &packages.Config{Mode: packages.NeedName | packages.NeedTypes, Dir: dir}
+	pkgs, err := packages.Load(cfg, ".")
+	if err != nil {
+		return nil, nil, err
+	}
+	if len(pkgs) != 1 || pkgs[0].Types == nil {
+		return nil, nil, fmt.Errorf("no package found in %s", dir)
+	}
+	pkg := pkgs[0].Types
+	obj, ok := pkg.Scope().Lookup(typeName).(*types.TypeName)
+	if !ok {
+		return nil, nil, fmt.Errorf("type %s not found in package %s", typeName, pkg.Name())
+	}
+	if named, ok := obj.Type().(*types.Named); ok && named.TypeParams().Len() > 0 {
+		return nil, nil, fmt.Errorf("generic type %s is not supported", typeName)
+	}
+	st, ok := obj.Type().Underlying().(*types.Struct)
+	if !ok {
+		return nil, nil, fmt.Errorf("type %s is not a struct", typeName)
+	}
+	return pkg, st, nil
+}
+
+// generateAccessors returns the formatted source of accessor methods for the exported
+// Option fields of typeName in the package in dir: for a field F of type Option[T], an
+// FOr(def T) T method returning the value or def, and a HasF() bool method.
+func generateAccessors(dir, typeName string) ([]byte, error) {
+	pkg, st, err := loadStruct(dir, typeName)
+	if err != nil {
+		return nil, err
+	}
+	g := &generator{pkg: pkg, imports: map[string]string{}}
+	recv := strings.ToLower(typeName[:1])
+
+	for i := range st.NumFields() {
+		f := st.Field(i)
+		elem, ok := optionElem(f.Type())
+		if !ok || !f.Exported() || f.Embedded() {
+			continue
+		}
+		elemType := g.typeString(elem)
+		g.printf("// %sOr returns the %s field's value, or def if it is None.\n", f.Name(), f.Name())
+		g.printf("func (%s %s) %sOr(def %s) %s {\n\treturn %s.%s.UnwrapOr(def)\n}\n\n",
+			recv, typeName, f.Name(), elemType, elemType, recv, f.Name())
+		g.printf("// Has%s reports whether the %s field is set.\n", f.Name(), f.Name())
+		g.printf("func (%s %s) Has%s() bool {\n\treturn %s.%s.IsSome()\n}\n\n",
+			recv, typeName, f.Name(), recv, f.Name())
+	}
+	if g.buf.Len() == 0 {
+		return nil, fmt.Errorf("type %s has no exported Option fields", typeName)
+	}
+	return g.source()
+}
+
+// optionElem returns T if t is jagain.Option[T].
+func optionElem(t types.Type) (types.Type, bool) {
+	named, ok := t.(*types.Named)
+	if !ok || named.TypeArgs().Len() != 1 {
+		return nil, false
+	}
+	obj := named.Origin().Obj()
+	if obj.Pkg() == nil || obj.Pkg().Path() != jagainPath || obj.Name() != "Option" {
+		return nil, false
+	}
+	return named.TypeArgs().At(0), true
+}
+
+// generate returns the formatted source of a struct named name that mirrors typeName in
+// the package in dir, with Option fields in place of pointers and sql Null types.
+func generate(dir, typeName, name string) ([]byte, error) {
+	pkg, st, err := loadStruct(dir, typeName)
+	if err != nil {
+		return nil, err
+	}
+	g := &generator{pkg: pkg, imports: map[string]string{}}
+
+	var fields []field
+	for i := range st.NumFields() {
+		fields = append(fields, g.field(st.Field(i), st.Tag(i)))
+	}
+
+	g.printf("// %s mirrors %s, using jagain Options for its optional fields.\n", name, typeName)
+	g.printf("type %s struct {\n", name)
+	for _, f := range fields {
+		if f.embedded {
+			g.printf("%s", f.typ)
+		} else {
+			g.printf("%s %s", f.name, f.typ)
+		}
+		if f.tag != "" {
+			g.printf(" `%s`", f.tag)
+		}
+		g.printf("\n")
+	}
+	g.printf("}\n\n")
+
+	g.printf("// %sFrom%s converts a %s to a %s.\n", name, typeName, typeName, name)
+	g.printf("func %sFrom%s(v %s) %s {\n\treturn %s{\n", name, typeName, typeName, name, name)
+	for _, f := range fields {
+		g.printf("%s: %s,\n", f.name, fmt.Sprintf(f.from, "v."+f.name))
+	}
+	g.printf("}\n}\n\n")
+
+	g.printf("// To%s converts a %s back to a %s.\n", typeName, name, typeName)
+	g.printf("func (v %s) To%s() %s {\n\treturn %s{\n", name, typeName, typeName, typeName)
+	for _, f := range fields {
+		g.printf("%s: %s,\n", f.name, fmt.Sprintf(f.to, "v."+f.name))
+	}
+	g.printf("}\n}\n")
+
+	return g.source()
+}
+
+// field maps a source struct field to its generated counterpart. Fields that become
+// Options have their json omitempty option swapped for omitzero, since only omitzero
+// omits a None.
+func (g *generator) field(v *types.Var, tag string) field {
+	f := field{name: v.Name(), tag: omitzeroTag(tag), embedded: v.Embedded(), from: "%s", to: "%s"}
+	t := v.Type()
+	if ptr, ok := t.(*types.Pointer); ok && !v.Embedded() {
+		f.typ = g.option(ptr.Elem())
+		f.from = g.qualified(jagainPath, "jagain") + ".FromPtr(%s)"
+		f.to = "%s.ToPtr()"
+		return f
+	}
+	if named, ok := t.(*types.Named); ok && !v.Embedded() {
+		obj := named.Origin().Obj()
+		if obj.Pkg() != nil && obj.Pkg().Path() == "database/sql" {
+			jagain := g.qualified(jagainPath, "jagain")
+			if obj.Name() == "Null" && named.TypeArgs().Len() == 1 {
+				f.typ = g.option(named.TypeArgs().At(0))
+				f.from = jagain + ".FromSQLNull(%s)"
+				f.to = jagain + ".ToSQLNull(%s)"
+				return f
+			}
+			if n, ok := nullTypes[obj.Name()]; ok {
+				elem := n.elem
+				if elem == "time.Time" {
+					elem = g.qualified("time", "time") + ".Time"
+				}
+				f.typ = fmt.Sprintf("%s.Option[%s]", jagain, elem)
+				f.from = jagain + "." + n.from + "(%s)"
+				f.to = jagain + "." + n.to + "(%s)"
+				return f
+			}
+		}
+	}
+	f.tag = tag
+	f.typ = g.typeString(t)
+	return f
+}
+
+// omitzeroTag returns tag with omitempty replaced by omitzero in its json key.
+func omitzeroTag(tag string) string {
+	value, ok := reflect.StructTag(tag).Lookup("json")
+	if !ok {
+		return tag
+	}
+	parts := strings.Split(value, ",")
+	i := slices.Index(parts[1:], "omitempty")
+	if i < 0 {
+		return tag
+	}
+	if slices.Contains(parts[1:], "omitzero") {
+		parts = slices.Delete(parts, i+1, i+2)
+	} else {
+		parts[i+1] = "omitzero"
+	}
+	return strings.Replace(tag, `json:"`+value+`"`, `json:"`+strings.Join(parts, ",")+`"`, 1)
+}
+
+// option returns the source form of jagain.Option[elem].
+func (g *generator) option(elem types.Type) string {
+	return fmt.Sprintf("%s.Option[%s]", g.qualified(jagainPath, "jagain"), g.typeString(elem))
+}
+
+// typeString returns the source form of t, recording the imports it needs.
+func (g *generator) typeString(t types.Type) string {
+	return types.TypeString(t, func(p *types.Package) string {
+		if p == g.pkg {
+			return ""
+		}
+		return g.qualified(p.Path(), p.Name())
+	})
+}
+
+// qualified records an import of path and returns the name to qualify it with.
+func (g *generator) qualified(path, name string) string {
+	g.imports[path] = name
+	return name
+}
+
+func (g *generator) printf(format string, args ...any) {
+	fmt.Fprintf(&g.buf, format, args...)
+}
+
+// source returns the generated file, formatted and with its package clause and imports.
+func (g *generator) source() ([]byte, error) {
+	var out bytes.Buffer
+	fmt.Fprintf(&out, "// Code generated by jagain-gen; DO NOT EDIT.\n\npackage %s\n\n", g.pkg.Name())
+	if len(g.imports) == 1 {
+		for path := range g.imports {
+			fmt.Fprintf(&out, "import %q\n\n", path)
+		}
+	} else if len(g.imports) > 1 {
+		// Standard library imports come first, separated from the rest by a blank line.
+		paths := slices.SortedFunc(maps.Keys(g.imports), func(a, b string) int {
+			if std(a) != std(b) {
+				if std(a) {
+					return -1
+				}
+				return 1
+			}
+			return strings.Compare(a, b)
+		})
+		out.WriteString("import (\n")
+		for i, path := range paths {
+			if i > 0 && std(path) != std(paths[i-1]) {
+				out.WriteString("\n")
+			}
+			fmt.Fprintf(&out, "%q\n", path)
+		}
+		out.WriteString(")\n\n")
+	}
+	out.Write(g.buf.Bytes())
+	return format.Source(out.Bytes())
+}
+
+// std reports whether path belongs to the standard library.
+func std(path string) bool {
+	first, _, _ := strings.Cut(path, "/")
+	return !strings.Contains(first, ".")
+}
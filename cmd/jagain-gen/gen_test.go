@@ -0,0 +1,56 @@
+package main
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestGenerate(t *testing.T) {
+	dir := filepath.Join("testdata", "dto")
+
+	// Test the output matches the checked-in file, which the dto package compiles with
+	got, err := generate(dir, "UserDTO", "User")
+	if err != nil {
+		t.Fatalf("Failed to generate: %v", err)
+	}
+	want, err := os.ReadFile(filepath.Join(dir, "userdto_jagain.go"))
+	if err != nil {
+		t.Fatalf("Failed to read the expected output: %v", err)
+	}
+	if !bytes.Equal(got, want) {
+		t.Errorf("Expected generated code to match userdto_jagain.go, got:\n%s", got)
+	}
+
+	// Test errors for unknown and non-struct types
+	if _, err := generate(dir, "Missing", "X"); err == nil || !strings.Contains(err.Error(), "not found") {
+		t.Errorf("Expected an error for a missing type, got %v", err)
+	}
+	if _, err := generate(filepath.Join("testdata", "notstruct"), "ID", "X"); err == nil || !strings.Contains(err.Error(), "not a struct") {
+		t.Errorf("Expected an error for a non-struct type, got %v", err)
+	}
+}
+
+func TestGenerateAccessors(t *testing.T) {
+	dir := filepath.Join("testdata", "accessors")
+
+	// Test the output matches the checked-in file, which the accessors package compiles with
+	got, err := generateAccessors(dir, "User")
+	if err != nil {
+		t.Fatalf("Failed to generate: %v", err)
+	}
+	want, err := os.ReadFile(filepath.Join(dir, "user_accessors.go"))
+	if err != nil {
+		t.Fatalf("Failed to read the expected output: %v", err)
+	}
+	if !bytes.Equal(got, want) {
+		t.Errorf("Expected generated code to match user_accessors.go, got:\n%s", got)
+	}
+
+	// Test types without Option fields are rejected
+	if _, err := generateAccessors(filepath.Join("testdata", "dto"), "Address"); err == nil {
+		t.Errorf("Expected an error for a type without Option fields")
+	}
+}
@@ -0,0 +1,13 @@
+module github.com/dendianugerah/jagain/cmd/jagain-gen
+
+go 1.26.0
+
+require golang.org/x/tools v0.50.0
+
+require (
+	github.com/google/go-cmp v0.7.0 // indirect
+	golang.org/x/mod v0.41.0 // indirect
+	golang.org/x/sync v0.23.0 // indirect
+)
+
+replace github.com/dendianugerah/jagain => ../..
@@ -0,0 +1,73 @@
+// Command jagain-gen generates code for working with jagain Options.
+//
+// Given a struct whose optional fields are pointers or database/sql Null types, it writes
+// a parallel struct that uses jagain.Option for those fields, together with functions
+// converting between the two. It is meant to be run with go generate:
+//
+//	//go:generate jagain-gen -type UserDTO -name User
+//
+// This writes userdto_jagain.go next to the source file, declaring User,
+// UserFromUserDTO and User.ToUserDTO.
+//
+// With -accessors, it instead writes methods for each exported Option field of the type,
+// so callers can use the struct without knowing the jagain API:
+//
+//	//go:generate jagain-gen -accessors -type User
+//
+// For a field Email of type jagain.Option[string], this declares
+// EmailOr(def string) string and HasEmail() bool in user_accessors.go.
+package main
+
+import (
+	"flag"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+func main() {
+	typeName := flag.String("type", "", "name of the source struct (required)")
+	name := flag.String("name", "", "name of the generated struct (default: the source name with an Opt suffix)")
+	output := flag.String("output", "", "output file name (default: <type>_jagain.go, or <type>_accessors.go with -accessors)")
+	accessors := flag.Bool("accessors", false, "generate accessor methods for the Option fields of the type")
+	flag.Usage = func() {
+		fmt.Fprintf(os.Stderr, "usage: jagain-gen -type T [-name N] [-output file] [dir]\n")
+		fmt.Fprintf(os.Stderr, "       jagain-gen -accessors -type T [-output file] [dir]\n")
+		flag.PrintDefaults()
+	}
+	flag.Parse()
+	if *typeName == "" || flag.NArg() > 1 {
+		flag.Usage()
+		os.Exit(2)
+	}
+
+	dir := "."
+	if flag.NArg() == 1 {
+		dir = flag.Arg(0)
+	}
+	var src []byte
+	var err error
+	if *accessors {
+		if *output == "" {
+			*output = strings.ToLower(*typeName) + "_accessors.go"
+		}
+		src, err = generateAccessors(dir, *typeName)
+	} else {
+		if *name == "" {
+			*name = *typeName + "Opt"
+		}
+		if *output == "" {
+			*output = strings.ToLower(*typeName) + "_jagain.go"
+		}
+		src, err = generate(dir, *typeName, *name)
+	}
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "jagain-gen: %v\n", err)
+		os.Exit(1)
+	}
+	if err := os.WriteFile(filepath.Join(dir, *output), src, 0o644); err != nil {
+		fmt.Fprintf(os.Stderr, "jagain-gen: %v\n", err)
+		os.Exit(1)
+	}
+}
@@ -0,0 +1,18 @@
+package accessors
+
+import (
+	"time"
+
+	"github.com/dendianugerah/jagain"
+)
+
+//go:generate jagain-gen -accessors -type User
+
+type User struct {
+	ID       int
+	Email    jagain.Option[string]
+	Age      jagain.Option[int]
+	Birthday jagain.Option[time.Time]
+	Friends  jagain.Option[[]*User]
+	internal jagain.Option[string]
+}
@@ -0,0 +1,45 @@
+// Code generated by jagain-gen; DO NOT EDIT.
+
+package accessors
+
+import "time"
+
+// EmailOr returns the Email field's value, or def if it is None.
+func (u User) EmailOr(def string) string {
+	return u.Email.UnwrapOr(def)
+}
+
+// HasEmail reports whether the Email field is set.
+func (u User) HasEmail() bool {
+	return u.Email.IsSome()
+}
+
+// AgeOr returns the Age field's value, or def if it is None.
+func (u User) AgeOr(def int) int {
+	return u.Age.UnwrapOr(def)
+}
+
+// HasAge reports whether the Age field is set.
+func (u User) HasAge() bool {
+	return u.Age.IsSome()
+}
+
+// BirthdayOr returns the Birthday field's value, or def if it is None.
+func (u User) BirthdayOr(def time.Time) time.Time {
+	return u.Birthday.UnwrapOr(def)
+}
+
+// HasBirthday reports whether the Birthday field is set.
+func (u User) HasBirthday() bool {
+	return u.Birthday.IsSome()
+}
+
+// FriendsOr returns the Friends field's value, or def if it is None.
+func (u User) FriendsOr(def []*User) []*User {
+	return u.Friends.UnwrapOr(def)
+}
+
+// HasFriends reports whether the Friends field is set.
+func (u User) HasFriends() bool {
+	return u.Friends.IsSome()
+}
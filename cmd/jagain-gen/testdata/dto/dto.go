@@ -0,0 +1,28 @@
+package dto
+
+import (
+	"database/sql"
+	"time"
+)
+
+//go:generate jagain-gen -type UserDTO -name User
+
+type Address struct {
+	City string `json:"city"`
+}
+
+type Audit struct {
+	CreatedAt time.Time
+}
+
+type UserDTO struct {
+	Audit
+	ID        int                   `json:"id"`
+	Email     *string               `json:"email"`
+	Home      *Address              `json:"home,omitempty"`
+	Nickname  sql.NullString        `db:"nickname" json:"nickname,omitempty"`
+	DeletedAt sql.NullTime          `db:"deleted_at"`
+	Score     sql.Null[float32]     `db:"score"`
+	Tags      []string              `json:"tags,omitempty"`
+	Meta      map[string]*time.Time `json:"meta"`
+}
@@ -0,0 +1,52 @@
+// Code generated by jagain-gen; DO NOT EDIT.
+
+package dto
+
+import (
+	"time"
+
+	"github.com/dendianugerah/jagain"
+)
+
+// User mirrors UserDTO, using jagain Options for its optional fields.
+type User struct {
+	Audit
+	ID        int                      `json:"id"`
+	Email     jagain.Option[string]    `json:"email"`
+	Home      jagain.Option[Address]   `json:"home,omitzero"`
+	Nickname  jagain.Option[string]    `db:"nickname" json:"nickname,omitzero"`
+	DeletedAt jagain.Option[time.Time] `db:"deleted_at"`
+	Score     jagain.Option[float32]   `db:"score"`
+	Tags      []string                 `json:"tags,omitempty"`
+	Meta      map[string]*time.Time    `json:"meta"`
+}
+
+// UserFromUserDTO converts a UserDTO to a User.
+func UserFromUserDTO(v UserDTO) User {
+	return User{
+		Audit:     v.Audit,
+		ID:        v.ID,
+		Email:     jagain.FromPtr(v.Email),
+		Home:      jagain.FromPtr(v.Home),
+		Nickname:  jagain.FromNullString(v.Nickname),
+		DeletedAt: jagain.FromNullTime(v.DeletedAt),
+		Score:     jagain.FromSQLNull(v.Score),
+		Tags:      v.Tags,
+		Meta:      v.Meta,
+	}
+}
+
+// ToUserDTO converts a User back to a UserDTO.
+func (v User) ToUserDTO() UserDTO {
+	return UserDTO{
+		Audit:     v.Audit,
+		ID:        v.ID,
+		Email:     v.Email.ToPtr(),
+		Home:      v.Home.ToPtr(),
+		Nickname:  jagain.ToNullString(v.Nickname),
+		DeletedAt: jagain.ToNullTime(v.DeletedAt),
+		Score:     jagain.ToSQLNull(v.Score),
+		Tags:      v.Tags,
+		Meta:      v.Meta,
+	}
+}
@@ -0,0 +1,3 @@
+package notstruct
+
+type ID int
@@ -0,0 +1,14 @@
+module github.com/dendianugerah/jagain/cmd/jagainlint
+
+go 1.26.0
+
+require (
+	github.com/dendianugerah/jagain/jagainlint v0.0.0-00010101000000-000000000000
+	golang.org/x/tools v0.50.0
+)
+
+require golang.org/x/sync v0.23.0 // indirect
+
+replace github.com/dendianugerah/jagain => ../..
+
+replace github.com/dendianugerah/jagain/jagainlint => ../../jagainlint
@@ -0,0 +1,17 @@
+// Command jagainlint runs the jagainlint analyzers as a go vet tool:
+//
+//	go vet -vettool=$(which jagainlint) ./...
+package main
+
+import (
+	"github.com/dendianugerah/jagain/jagainlint"
+	"golang.org/x/tools/go/analysis/unitchecker"
+)
+
+func main() {
+	unitchecker.Main(
+		jagainlint.UnwrapAnalyzer,
+		jagainlint.DiscardAnalyzer,
+		jagainlint.OptionalAnalyzer,
+	)
+}
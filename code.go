@@ -0,0 +1,51 @@
+package jagain
+
+import "errors"
+
+// codeError attaches a machine-readable code to an error.
+type codeError struct {
+	code string
+	err  error
+}
+
+func (e *codeError) Error() string {
+	return e.err.Error()
+}
+
+func (e *codeError) Unwrap() error {
+	return e.err
+}
+
+// WithCode attaches a machine-readable code to err. The code does not change the error
+// message and survives wrapping with %w, so it can be read back with ErrorCode.
+// A nil err is returned unchanged.
+func WithCode(code string, err error) error {
+	if err == nil {
+		return nil
+	}
+	return &codeError{code: code, err: err}
+}
+
+// ErrCode creates a Result containing err tagged with a machine-readable code.
+func ErrCode[T any](code string, err error) Result[T] {
+	return Err[T](WithCode(code, err))
+}
+
+// ErrorCode returns the code attached to err by WithCode or ErrCode.
+// When several codes are present in the chain, the outermost one wins.
+func ErrorCode(err error) Option[string] {
+	var ce *codeError
+	if !errors.As(err, &ce) {
+		return None[string]()
+	}
+	return Some(ce.code)
+}
+
+// Code returns the error code carried by an Err result, or None for Ok results and
+// errors without a code.
+func Code[T any](r Result[T]) Option[string] {
+	if r.valid {
+		return None[string]()
+	}
+	return ErrorCode(r.err)
+}
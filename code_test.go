@@ -0,0 +1,39 @@
+package jagain
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+)
+
+func TestCode(t *testing.T) {
+	errMissing := errors.New("user not found")
+
+	// Test ErrCode and Code
+	r := ErrCode[int]("USER_NOT_FOUND", errMissing)
+	if Code(r).UnwrapOr("") != "USER_NOT_FOUND" {
+		t.Errorf("Expected code to be Some(USER_NOT_FOUND), got %v", Code(r))
+	}
+	if r.UnwrapErr().Error() != "user not found" || !errors.Is(r.UnwrapErr(), errMissing) {
+		t.Errorf("Expected code to leave the error unchanged, got %v", r.UnwrapErr())
+	}
+
+	// Test that codes survive MapErr wrapping
+	wrapped := r.MapErr(func(err error) error { return fmt.Errorf("load profile: %w", err) })
+	if Code(wrapped).UnwrapOr("") != "USER_NOT_FOUND" {
+		t.Errorf("Expected code to survive wrapping, got %v", Code(wrapped))
+	}
+
+	// Test results without a code
+	if !Code(Ok(1)).IsNone() || !Code(Err[int](errMissing)).IsNone() {
+		t.Errorf("Expected Ok and uncoded errors to have no code")
+	}
+	if WithCode("X", nil) != nil {
+		t.Errorf("Expected WithCode(nil) to be nil")
+	}
+
+	// Test that the JSON error encoder reports the code
+	if body := encodeErrorBody(t, WithCode("USER_NOT_FOUND", ErrNoValue)); body != `{"code":"USER_NOT_FOUND","error":"option contains no value"}` {
+		t.Errorf("Expected code in the error body, got %s", body)
+	}
+}
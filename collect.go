@@ -0,0 +1,25 @@
+package jagain
+
+import "context"
+
+// CollectUntilDeadline receives Results from ch until it is closed or ctx is done, which
+// lets scatter-gather code answer with whatever arrived in time. It returns the success
+// values and errors in the order they were received, and incomplete is true if ctx ended
+// collection before ch was closed.
+func CollectUntilDeadline[T any](ctx context.Context, ch <-chan Result[T]) (ok []T, errs []error, incomplete bool) {
+	for {
+		select {
+		case <-ctx.Done():
+			return ok, errs, true
+		case r, open := <-ch:
+			if !open {
+				return ok, errs, false
+			}
+			if r.valid {
+				ok = append(ok, r.value)
+			} else {
+				errs = append(errs, r.err)
+			}
+		}
+	}
+}
@@ -0,0 +1,37 @@
+package jagain
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestCollectUntilDeadline(t *testing.T) {
+	errFailed := errors.New("failed")
+
+	// Test a channel closed before the deadline
+	ch := make(chan Result[int], 3)
+	ch <- Ok(1)
+	ch <- Err[int](errFailed)
+	ch <- Ok(2)
+	close(ch)
+	ok, errs, incomplete := CollectUntilDeadline(context.Background(), ch)
+	if len(ok) != 2 || ok[1] != 2 || len(errs) != 1 || errs[0] != errFailed || incomplete {
+		t.Errorf("Expected [1 2], one error and a complete collection, got %v, %v, %v", ok, errs, incomplete)
+	}
+
+	// Test the deadline truncates collection
+	slow := make(chan Result[int], 2)
+	go func() {
+		slow <- Ok(1)
+		time.Sleep(time.Second)
+		slow <- Ok(2)
+	}()
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+	ok, errs, incomplete = CollectUntilDeadline(ctx, slow)
+	if len(ok) != 1 || len(errs) != 0 || !incomplete {
+		t.Errorf("Expected [1] and an incomplete collection, got %v, %v, %v", ok, errs, incomplete)
+	}
+}
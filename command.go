@@ -0,0 +1,123 @@
+package jagain
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"os/exec"
+	"time"
+)
+
+// commandWaitDelay bounds how long RunCommand waits for output after ctx is done.
+const commandWaitDelay = time.Second
+
+// CommandOutput holds what a command wrote and how it exited.
+type CommandOutput struct {
+	Stdout   []byte
+	Stderr   []byte
+	ExitCode int
+}
+
+// StartError reports that a command could not be started, for example because the
+// program was not found.
+type StartError struct {
+	Name string
+	Err  error
+}
+
+// Error implements the error interface.
+func (e *StartError) Error() string {
+	return fmt.Sprintf("command %s: %v", e.Name, e.Err)
+}
+
+// Unwrap returns the underlying error.
+func (e *StartError) Unwrap() error {
+	return e.Err
+}
+
+// WaitError reports that a command ran but waiting for it failed, for example because
+// its output could not be copied or a child process kept the output open past the wait
+// delay, which is reported as exec.ErrWaitDelay. Output holds what was captured.
+type WaitError struct {
+	Name   string
+	Err    error
+	Output CommandOutput
+}
+
+// Error implements the error interface.
+func (e *WaitError) Error() string {
+	return fmt.Sprintf("command %s: waiting: %v", e.Name, e.Err)
+}
+
+// Unwrap returns the underlying error.
+func (e *WaitError) Unwrap() error {
+	return e.Err
+}
+
+// ExitCodeError reports that a command exited with a non-zero status.
+// Output holds everything the command wrote, including the exit code.
+type ExitCodeError struct {
+	Name   string
+	Output CommandOutput
+}
+
+// Error implements the error interface. It includes the start of stderr, which usually
+// explains the failure.
+func (e *ExitCodeError) Error() string {
+	msg := fmt.Sprintf("command %s exited with code %d", e.Name, e.Output.ExitCode)
+	if line, _, _ := bytes.Cut(bytes.TrimSpace(e.Output.Stderr), []byte("\n")); len(line) > 0 {
+		msg += ": " + string(line)
+	}
+	return msg
+}
+
+// CanceledError reports that a command was killed because its context was done.
+// It unwraps to the context's error, so errors.Is matches context.Canceled or
+// context.DeadlineExceeded.
+type CanceledError struct {
+	Name   string
+	Err    error
+	Output CommandOutput
+}
+
+// Error implements the error interface.
+func (e *CanceledError) Error() string {
+	return fmt.Sprintf("command %s: %v", e.Name, e.Err)
+}
+
+// Unwrap returns the context's error.
+func (e *CanceledError) Unwrap() error {
+	return e.Err
+}
+
+// RunCommand runs the named program with args and waits for it, capturing its output.
+// The process is killed if ctx is done first. Failures are reported as *StartError,
+// *ExitCodeError, *CanceledError or *WaitError, each carrying what was captured.
+func RunCommand(ctx context.Context, name string, args ...string) Result[CommandOutput] {
+	var stdout, stderr bytes.Buffer
+	cmd := exec.CommandContext(ctx, name, args...)
+	cmd.Stdout = &stdout
+	cmd.Stderr = &stderr
+	// Child processes may keep the output pipes open after the command is killed;
+	// stop waiting for them shortly afterwards.
+	cmd.WaitDelay = commandWaitDelay
+
+	if err := cmd.Start(); err != nil {
+		return Err[CommandOutput](&StartError{Name: name, Err: err})
+	}
+	err := cmd.Wait()
+	out := CommandOutput{Stdout: stdout.Bytes(), Stderr: stderr.Bytes(), ExitCode: cmd.ProcessState.ExitCode()}
+
+	if ctxErr := ctx.Err(); ctxErr != nil && err != nil {
+		return Err[CommandOutput](&CanceledError{Name: name, Err: ctxErr, Output: out})
+	}
+	var exitErr *exec.ExitError
+	if errors.As(err, &exitErr) {
+		return Err[CommandOutput](&ExitCodeError{Name: name, Output: out})
+	}
+	if err != nil {
+		return Err[CommandOutput](&WaitError{Name: name, Err: err, Output: out})
+	}
+	return Ok(out)
+}
@@ -0,0 +1,64 @@
+package jagain
+
+import (
+	"context"
+	"errors"
+	"os/exec"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestRunCommand(t *testing.T) {
+	if _, err := exec.LookPath("sh"); err != nil {
+		t.Skip("sh is not available")
+	}
+	ctx := context.Background()
+
+	// Test captured output
+	r := RunCommand(ctx, "sh", "-c", "echo out; echo err >&2")
+	if r.IsErr() {
+		t.Fatalf("Expected Ok, got %v", r.UnwrapErr())
+	}
+	if out := r.Unwrap(); string(out.Stdout) != "out\n" || string(out.Stderr) != "err\n" || out.ExitCode != 0 {
+		t.Errorf("Expected captured output, got %+v", out)
+	}
+
+	// Test non-zero exit
+	r = RunCommand(ctx, "sh", "-c", "echo partial; echo 'bad input' >&2; exit 3")
+	var exitErr *ExitCodeError
+	if !errors.As(r.UnwrapErr(), &exitErr) {
+		t.Fatalf("Expected an ExitCodeError, got %v", r)
+	}
+	if exitErr.Output.ExitCode != 3 || string(exitErr.Output.Stdout) != "partial\n" {
+		t.Errorf("Expected exit code 3 and the partial output, got %+v", exitErr.Output)
+	}
+	if !strings.Contains(exitErr.Error(), "code 3: bad input") {
+		t.Errorf("Expected the message to include stderr, got %q", exitErr.Error())
+	}
+
+	// Test context cancellation
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+	r = RunCommand(ctx, "sh", "-c", "exec sleep 5")
+	var canceled *CanceledError
+	if !errors.As(r.UnwrapErr(), &canceled) || !errors.Is(r.UnwrapErr(), context.DeadlineExceeded) {
+		t.Errorf("Expected a CanceledError matching DeadlineExceeded, got %v", r)
+	}
+
+	// Test a child process keeping the output open after the command exits
+	r = RunCommand(context.Background(), "sh", "-c", "sleep 3 & echo out")
+	var waitErr *WaitError
+	if !errors.As(r.UnwrapErr(), &waitErr) || !errors.Is(r.UnwrapErr(), exec.ErrWaitDelay) {
+		t.Errorf("Expected a WaitError matching exec.ErrWaitDelay, got %v", r)
+	} else if string(waitErr.Output.Stdout) != "out\n" {
+		t.Errorf("Expected the captured output, got %+v", waitErr.Output)
+	}
+
+	// Test a missing program
+	r = RunCommand(context.Background(), "jagain-no-such-program")
+	var startErr *StartError
+	if !errors.As(r.UnwrapErr(), &startErr) || !errors.Is(r.UnwrapErr(), exec.ErrNotFound) {
+		t.Errorf("Expected a StartError matching exec.ErrNotFound, got %v", r)
+	}
+}
@@ -0,0 +1,232 @@
+// Package config populates configuration structs from files, environment variables and
+// command-line flags, using jagain Options for settings that may be left unset.
+//
+// Fields are named by their `config` tag, or by the field name in snake_case; nested
+// structs add a dotted prefix. A field Host inside a field Database has the key
+// "database.host", which is read from the "database" table of a file, from the
+// environment variable APP_DATABASE_HOST when the Env prefix is "APP", and from the
+// flag -database.host. Sources are applied in the order given, so later sources take
+// priority:
+//
+//	cfg := config.Load[Settings](
+//		config.File("settings.toml"),
+//		config.Env("APP"),
+//		config.Flags(os.Args[1:]),
+//	)
+//
+// A `default` tag supplies a value used when no source sets the key. Option fields that
+// are never set are None; any other field that is never set and has no default is
+// reported as not configured. Values are parsed with the field's UnmarshalText method
+// when it has one, time.Duration values with time.ParseDuration, and slices as
+// comma-separated lists. Once populated, the struct is checked with
+// jagain.ValidateStruct, so `jagain` validation tags apply too.
+package config
+
+import (
+	"database/sql"
+	"encoding"
+	"errors"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"time"
+	"unicode"
+
+	"github.com/dendianugerah/jagain"
+)
+
+// field is a settable leaf of the configuration struct.
+type field struct {
+	key     string
+	index   []int
+	typ     reflect.Type
+	option  bool
+	def     string
+	hasDef  bool
+	boolean bool
+}
+
+// Load builds a T from sources, applied in order. Problems with individual keys, such as
+// unparsable values, unknown keys in files and flags, and required keys that no source
+// sets, are all reported together as jagain.ValidationErrors. A source that cannot be
+// read at all, such as a missing file, fails the load immediately.
+func Load[T any](sources ...Source) jagain.Result[T] {
+	var cfg T
+	v := reflect.ValueOf(&cfg).Elem()
+	if v.Kind() != reflect.Struct {
+		return jagain.Err[T](fmt.Errorf("config: %s is not a struct", v.Type()))
+	}
+	fields := collectFields(v.Type(), "", nil, nil)
+	byKey := make(map[string]*field, len(fields))
+	for _, f := range fields {
+		byKey[f.key] = f
+	}
+
+	values := map[string]string{}
+	var errs jagain.ValidationErrors
+	for _, src := range sources {
+		vals, err := src.load(byKey)
+		var keyErrs jagain.ValidationErrors
+		if errors.As(err, &keyErrs) {
+			errs = append(errs, keyErrs...)
+		} else if err != nil {
+			return jagain.Err[T](fmt.Errorf("config: %s: %w", src.name, err))
+		}
+		for k, val := range vals {
+			values[k] = val
+		}
+	}
+
+	for _, f := range fields {
+		text, ok := values[f.key]
+		if !ok && f.hasDef {
+			text, ok = f.def, true
+		}
+		if !ok {
+			if !f.option {
+				errs = append(errs, &jagain.FieldError{Path: f.key, Err: errors.New("is not configured")})
+			}
+			continue
+		}
+		if err := setField(v.FieldByIndex(f.index), f, text); err != nil {
+			errs = append(errs, &jagain.FieldError{Path: f.key, Err: fmt.Errorf("invalid value %q: %w", text, err)})
+		}
+	}
+	if len(errs) > 0 {
+		return jagain.Err[T](errs)
+	}
+	return jagain.ValidateStruct(cfg)
+}
+
+var (
+	optionIface      = reflect.TypeFor[jagain.AnyOption]()
+	unmarshalerIface = reflect.TypeFor[encoding.TextUnmarshaler]()
+	durationType     = reflect.TypeFor[time.Duration]()
+)
+
+// collectFields lists the settable leaves of t, descending into nested structs.
+func collectFields(t reflect.Type, prefix string, index []int, fields []*field) []*field {
+	for i := range t.NumField() {
+		sf := t.Field(i)
+		tag := sf.Tag.Get("config")
+		if !sf.IsExported() || tag == "-" {
+			continue
+		}
+		name := tag
+		if name == "" {
+			name = snakeCase(sf.Name)
+		}
+		key := name
+		if prefix != "" {
+			key = prefix + "." + name
+		}
+		idx := append(append([]int(nil), index...), i)
+
+		f := &field{key: key, index: idx, typ: sf.Type}
+		f.def, f.hasDef = sf.Tag.Lookup("default")
+		elem := sf.Type
+		if sf.Type.Implements(optionIface) {
+			f.option = true
+			unwrap, _ := sf.Type.MethodByName("UnwrapOr")
+			elem = unwrap.Type.Out(0)
+		}
+		f.boolean = elem.Kind() == reflect.Bool
+		if !f.option && sf.Type.Kind() == reflect.Struct && !reflect.PointerTo(sf.Type).Implements(unmarshalerIface) {
+			fields = collectFields(sf.Type, key, idx, fields)
+			continue
+		}
+		fields = append(fields, f)
+	}
+	return fields
+}
+
+// setField parses text into the field v described by f.
+func setField(v reflect.Value, f *field, text string) error {
+	if !f.option {
+		return parseValue(v, text)
+	}
+	if text == "" {
+		v.SetZero()
+		return nil
+	}
+	unwrap, _ := f.typ.MethodByName("UnwrapOr")
+	elem := reflect.New(unwrap.Type.Out(0)).Elem()
+	if err := parseValue(elem, text); err != nil {
+		return err
+	}
+	return v.Addr().Interface().(sql.Scanner).Scan(elem.Interface())
+}
+
+// parseValue parses text into v.
+func parseValue(v reflect.Value, text string) error {
+	if u, ok := v.Addr().Interface().(encoding.TextUnmarshaler); ok {
+		return u.UnmarshalText([]byte(text))
+	}
+	if v.Type() == durationType {
+		d, err := time.ParseDuration(text)
+		if err != nil {
+			return err
+		}
+		v.SetInt(int64(d))
+		return nil
+	}
+
+	switch v.Kind() {
+	case reflect.String:
+		v.SetString(text)
+	case reflect.Bool:
+		b, err := strconv.ParseBool(text)
+		if err != nil {
+			return err
+		}
+		v.SetBool(b)
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		n, err := strconv.ParseInt(text, 10, v.Type().Bits())
+		if err != nil {
+			return err
+		}
+		v.SetInt(n)
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		n, err := strconv.ParseUint(text, 10, v.Type().Bits())
+		if err != nil {
+			return err
+		}
+		v.SetUint(n)
+	case reflect.Float32, reflect.Float64:
+		n, err := strconv.ParseFloat(text, v.Type().Bits())
+		if err != nil {
+			return err
+		}
+		v.SetFloat(n)
+	case reflect.Slice:
+		var parts []string
+		if text != "" {
+			parts = strings.Split(text, ",")
+		}
+		slice := reflect.MakeSlice(v.Type(), len(parts), len(parts))
+		for i, part := range parts {
+			if err := parseValue(slice.Index(i), strings.TrimSpace(part)); err != nil {
+				return err
+			}
+		}
+		v.Set(slice)
+	default:
+		return fmt.Errorf("unsupported type %s", v.Type())
+	}
+	return nil
+}
+
+// snakeCase converts a Go field name such as "DBHost" to "db_host".
+func snakeCase(name string) string {
+	runes := []rune(name)
+	var b strings.Builder
+	for i, r := range runes {
+		if unicode.IsUpper(r) && i > 0 &&
+			(unicode.IsLower(runes[i-1]) || i+1 < len(runes) && unicode.IsLower(runes[i+1])) {
+			b.WriteByte('_')
+		}
+		b.WriteRune(unicode.ToLower(r))
+	}
+	return b.String()
+}
@@ -0,0 +1,146 @@
+package config
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/dendianugerah/jagain"
+)
+
+type database struct {
+	Host string `config:"host"`
+	Port int    `default:"5432"`
+}
+
+type settings struct {
+	Name     string
+	Database database
+	Timeout  time.Duration         `default:"5s"`
+	Tags     []string              `default:""`
+	Debug    bool                  `default:"false"`
+	APIKey   jagain.Option[string] `config:"api_key"`
+	Retries  jagain.Option[int]    `default:"3"`
+	Deadline jagain.Option[time.Duration]
+	Workers  int `default:"4" jagain:"min=1"`
+}
+
+func writeFile(t *testing.T, name, content string) string {
+	t.Helper()
+	path := filepath.Join(t.TempDir(), name)
+	if err := os.WriteFile(path, []byte(content), 0o600); err != nil {
+		t.Fatalf("Failed to write %s: %v", name, err)
+	}
+	return path
+}
+
+func TestLoad(t *testing.T) {
+	file := writeFile(t, "app.toml", `
+name = "from-file"
+tags = ["a", "b"]
+
+[database]
+host = "db.internal"
+port = 6543
+`)
+	t.Setenv("APP_NAME", "from-env")
+	t.Setenv("APP_DEADLINE", "1m")
+
+	// Test sources in priority order, defaults and Options
+	r := Load[settings](File(file), Env("APP"), Flags([]string{"-debug", "--timeout=2s", "-api_key", "secret", "rest"}))
+	if r.IsErr() {
+		t.Fatalf("Expected Ok, got %v", r.UnwrapErr())
+	}
+	cfg := r.Unwrap()
+	if cfg.Name != "from-env" || cfg.Database.Host != "db.internal" || cfg.Database.Port != 6543 {
+		t.Errorf("Expected later sources to win, got %+v", cfg)
+	}
+	if cfg.Timeout != 2*time.Second || !cfg.Debug || cfg.Workers != 4 {
+		t.Errorf("Expected flags and defaults to apply, got %+v", cfg)
+	}
+	if len(cfg.Tags) != 2 || cfg.Tags[1] != "b" {
+		t.Errorf("Expected tags [a b], got %v", cfg.Tags)
+	}
+	if cfg.APIKey.UnwrapOr("") != "secret" || cfg.Retries.UnwrapOr(0) != 3 || cfg.Deadline.UnwrapOr(0) != time.Minute {
+		t.Errorf("Expected Options to be set, got %v, %v and %v", cfg.APIKey, cfg.Retries, cfg.Deadline)
+	}
+
+	// Test unset Options are None
+	r = Load[settings](Flags([]string{"-name=x", "-database.host=h"}))
+	if r.IsErr() {
+		t.Fatalf("Expected Ok, got %v", r.UnwrapErr())
+	}
+	if cfg := r.Unwrap(); !cfg.APIKey.IsNone() || !cfg.Deadline.IsNone() {
+		t.Errorf("Expected unset Options to be None, got %v and %v", cfg.APIKey, cfg.Deadline)
+	}
+}
+
+// logLevel has methods named like those of an Option but is not one.
+type logLevel struct{ name string }
+
+func (l logLevel) IsSome() bool               { return l.name != "" }
+func (l logLevel) UnwrapOr(def string) string { return def }
+func (l *logLevel) Scan(any) error            { return errors.New("Scan must not be used") }
+func (l *logLevel) UnmarshalText(text []byte) error {
+	l.name = string(text)
+	return nil
+}
+
+func TestLoadOptionLookalike(t *testing.T) {
+	// Test that only jagain Options are treated as Options
+	r := Load[struct{ Level logLevel }](Flags([]string{"-level=debug"}))
+	if r.IsErr() || r.Unwrap().Level.name != "debug" {
+		t.Errorf("Expected the level to be parsed as text, got %v", r)
+	}
+}
+
+func TestLoadErrors(t *testing.T) {
+	file := writeFile(t, "app.json", `{"name": "svc", "colour": "blue", "database": {"port": "x"}}`)
+
+	// Test every problem is reported at once
+	r := Load[settings](File(file), Flags([]string{"-retries=many", "-verbose", "-timeout"}))
+	errs := jagain.ValidationErrorsOf(r.UnwrapErr())
+	got := map[string]string{}
+	for _, e := range errs {
+		got[e.Path] = e.Err.Error()
+	}
+	for path, want := range map[string]string{
+		"colour":        "unknown key",
+		"verbose":       "unknown flag",
+		"timeout":       "flag needs a value",
+		"database.host": "is not configured",
+		"database.port": "invalid value",
+		"retries":       "invalid value",
+	} {
+		if !strings.Contains(got[path], want) {
+			t.Errorf("Expected %s to report %q, got %q", path, want, got[path])
+		}
+	}
+
+	// Test validation tags are checked after loading
+	r = Load[settings](Flags([]string{"-name=x", "-database.host=h", "-workers=0"}))
+	if errs := jagain.ValidationErrorsOf(r.UnwrapErr()); len(errs) != 1 {
+		t.Errorf("Expected one validation error, got %v", errs)
+	}
+
+	// Test unreadable sources fail immediately
+	r = Load[settings](File(filepath.Join(t.TempDir(), "missing.toml")))
+	if !errors.Is(r.UnwrapErr(), os.ErrNotExist) {
+		t.Errorf("Expected a missing file error, got %v", r.UnwrapErr())
+	}
+	r = Load[settings](OptionalFile(filepath.Join(t.TempDir(), "missing.toml")), Flags([]string{"-name=x", "-database.host=h"}))
+	if r.IsErr() {
+		t.Errorf("Expected a missing optional file to be skipped, got %v", r.UnwrapErr())
+	}
+}
+
+func TestSnakeCase(t *testing.T) {
+	for in, want := range map[string]string{"Name": "name", "DBHost": "db_host", "APIKey": "api_key", "MaxIdleConns": "max_idle_conns"} {
+		if got := snakeCase(in); got != want {
+			t.Errorf("Expected snakeCase(%q) to be %q, got %q", in, want, got)
+		}
+	}
+}
@@ -0,0 +1,174 @@
+package config
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/fs"
+	"maps"
+	"os"
+	"path/filepath"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/BurntSushi/toml"
+	"github.com/dendianugerah/jagain"
+)
+
+// Source supplies configuration values as text, keyed by the dotted field keys.
+type Source struct {
+	name string
+	load func(fields map[string]*field) (map[string]string, error)
+}
+
+// Env reads each key from the environment variable named by prefix, an underscore and
+// the upper-cased key with dots replaced by underscores. An empty prefix uses the key alone.
+func Env(prefix string) Source {
+	return Source{
+		name: "environment",
+		load: func(fields map[string]*field) (map[string]string, error) {
+			values := map[string]string{}
+			for key := range fields {
+				name := strings.ToUpper(strings.ReplaceAll(key, ".", "_"))
+				if prefix != "" {
+					name = prefix + "_" + name
+				}
+				if v, ok := os.LookupEnv(name); ok {
+					values[key] = v
+				}
+			}
+			return values, nil
+		},
+	}
+}
+
+// File reads a JSON or TOML file, chosen by its .json or .toml extension. Tables or
+// objects map to nested structs; arrays are read as comma-separated lists. Keys that
+// match no field are reported. A missing file is an error.
+func File(path string) Source {
+	return file(path, false)
+}
+
+// OptionalFile is like File but contributes no values when the file does not exist.
+func OptionalFile(path string) Source {
+	return file(path, true)
+}
+
+func file(path string, optional bool) Source {
+	return Source{
+		name: path,
+		load: func(fields map[string]*field) (map[string]string, error) {
+			data, err := os.ReadFile(path)
+			if optional && errors.Is(err, fs.ErrNotExist) {
+				return nil, nil
+			}
+			if err != nil {
+				return nil, err
+			}
+
+			var doc map[string]any
+			switch ext := filepath.Ext(path); ext {
+			case ".json":
+				err = json.Unmarshal(data, &doc)
+			case ".toml":
+				err = toml.Unmarshal(data, &doc)
+			default:
+				return nil, fmt.Errorf("unsupported file type %q", ext)
+			}
+			if err != nil {
+				return nil, err
+			}
+
+			values := map[string]string{}
+			var errs jagain.ValidationErrors
+			flatten(doc, "", fields, values, &errs)
+			if len(errs) > 0 {
+				return values, errs
+			}
+			return values, nil
+		},
+	}
+}
+
+// flatten adds the leaves of doc to values under their dotted keys.
+func flatten(doc map[string]any, prefix string, fields map[string]*field, values map[string]string, errs *jagain.ValidationErrors) {
+	for _, name := range slices.Sorted(maps.Keys(doc)) {
+		key := name
+		if prefix != "" {
+			key = prefix + "." + name
+		}
+		if table, ok := doc[name].(map[string]any); ok && fields[key] == nil {
+			flatten(table, key, fields, values, errs)
+			continue
+		}
+		if fields[key] == nil {
+			*errs = append(*errs, &jagain.FieldError{Path: key, Err: errors.New("unknown key")})
+			continue
+		}
+		values[key] = formatValue(doc[name])
+	}
+}
+
+// formatValue renders a decoded JSON or TOML value as text.
+func formatValue(v any) string {
+	switch v := v.(type) {
+	case string:
+		return v
+	case float64:
+		return strconv.FormatFloat(v, 'f', -1, 64)
+	case time.Time:
+		return v.Format(time.RFC3339Nano)
+	case []any:
+		parts := make([]string, len(v))
+		for i, item := range v {
+			parts[i] = formatValue(item)
+		}
+		return strings.Join(parts, ",")
+	case nil:
+		return ""
+	}
+	return fmt.Sprint(v)
+}
+
+// Flags reads command-line arguments of the form -key=value, -key value or, for boolean
+// fields, -key alone. Either one or two leading dashes may be used. Parsing stops at the
+// first non-flag argument or at "--". Flags that match no field are reported.
+func Flags(args []string) Source {
+	return Source{
+		name: "flags",
+		load: func(fields map[string]*field) (map[string]string, error) {
+			values := map[string]string{}
+			var errs jagain.ValidationErrors
+			for i := 0; i < len(args); i++ {
+				arg := args[i]
+				if arg == "--" || !strings.HasPrefix(arg, "-") || arg == "-" {
+					break
+				}
+				name, value, hasValue := strings.Cut(strings.TrimLeft(arg, "-"), "=")
+				f := fields[name]
+				if f == nil {
+					errs = append(errs, &jagain.FieldError{Path: name, Err: errors.New("unknown flag")})
+					continue
+				}
+				switch {
+				case hasValue:
+				case f.boolean:
+					value = "true"
+				case i+1 < len(args):
+					i++
+					value = args[i]
+				default:
+					errs = append(errs, &jagain.FieldError{Path: name, Err: errors.New("flag needs a value")})
+					continue
+				}
+				values[name] = value
+			}
+			if len(errs) > 0 {
+				return values, errs
+			}
+			return values, nil
+		},
+	}
+}
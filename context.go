@@ -0,0 +1,19 @@
+package jagain
+
+import "context"
+
+// CtxValue returns the value stored in ctx under key as an Option. It is None if there is
+// no value for key or if the value is not a T.
+func CtxValue[T any](ctx context.Context, key any) Option[T] {
+	v, ok := ctx.Value(key).(T)
+	if !ok {
+		return None[T]()
+	}
+	return Some(v)
+}
+
+// WithCtxValue returns a copy of ctx in which key is associated with value.
+// It is context.WithValue with the value's type fixed, so it pairs with CtxValue[T].
+func WithCtxValue[T any](ctx context.Context, key any, value T) context.Context {
+	return context.WithValue(ctx, key, value)
+}
@@ -0,0 +1,34 @@
+package jagain
+
+import (
+	"context"
+	"testing"
+)
+
+type ctxKey string
+
+func TestCtxValue(t *testing.T) {
+	ctx := WithCtxValue(context.Background(), ctxKey("user"), "jane")
+
+	// Test a stored value
+	if got := CtxValue[string](ctx, ctxKey("user")); got.UnwrapOr("") != "jane" {
+		t.Errorf("Expected Some(jane), got %v", got)
+	}
+
+	// Test missing keys and wrong types
+	if got := CtxValue[string](ctx, ctxKey("role")); !got.IsNone() {
+		t.Errorf("Expected a missing key to be None, got %v", got)
+	}
+	if got := CtxValue[int](ctx, ctxKey("user")); !got.IsNone() {
+		t.Errorf("Expected a value of another type to be None, got %v", got)
+	}
+
+	// Test interface types accept any implementation
+	ctx = WithCtxValue[error](ctx, ctxKey("err"), context.Canceled)
+	if got := CtxValue[error](ctx, ctxKey("err")); got.UnwrapOr(nil) != context.Canceled {
+		t.Errorf("Expected Some(context.Canceled), got %v", got)
+	}
+	if got := CtxValue[error](ctx, ctxKey("none")); !got.IsNone() {
+		t.Errorf("Expected a missing interface value to be None, got %v", got)
+	}
+}
@@ -0,0 +1,245 @@
+package jagain
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"strings"
+)
+
+// Optionify converts src, a struct whose optional fields are pointers or database/sql
+// Null types, into D, a parallel struct whose corresponding fields are Options. It
+// replaces hand-written mappings between storage rows and API types:
+//
+//	type userRow struct {
+//		ID       int64
+//		Nickname sql.NullString
+//		Manager  *int64
+//	}
+//
+//	type User struct {
+//		ID       int64          `json:"id"`
+//		Nickname Option[string] `json:"nickname"`
+//		Manager  Option[int64]  `json:"manager"`
+//	}
+//
+//	user := jagain.Optionify[User](row)
+//
+// Fields are matched by their json names, or their Go names when untagged, ignoring
+// case; fields tagged json:"-" are skipped, and fields without a counterpart are left
+// zero. Nested structs and slices are converted the same way, and numbers may change
+// type as long as they fit. Values that cannot be converted, including a missing value
+// for a field that is not optional, are reported together as ValidationErrors. So is an
+// Option in src whose counterpart in D is not an Option, which calls for Deoptionify.
+func Optionify[D, S any](src S) Result[D] {
+	return convert[D](src, true)
+}
+
+// Deoptionify is the inverse of Optionify: it converts src, a struct with Option fields,
+// into D, whose corresponding fields are pointers or database/sql Null types. A None is
+// stored as a nil pointer or an invalid Null. An Option in D whose counterpart in src is
+// not an Option is reported as an error, as it calls for Optionify.
+func Deoptionify[D, S any](src S) Result[D] {
+	return convert[D](src, false)
+}
+
+// convert converts src into D, into Options when toOptions is set and out of them otherwise.
+func convert[D, S any](src S, toOptions bool) Result[D] {
+	var dst D
+	var errs ValidationErrors
+	c := converter{toOptions: toOptions, errs: &errs}
+	c.convertValue(reflect.ValueOf(&src).Elem(), reflect.ValueOf(&dst).Elem(), "")
+	if len(errs) > 0 {
+		return Err[D](errs)
+	}
+	return Ok(dst)
+}
+
+// converter holds the direction of a conversion and the problems found so far.
+type converter struct {
+	toOptions bool
+	errs      *ValidationErrors
+}
+
+// convertValue stores src in dst, appending a FieldError for every problem under path.
+func (c converter) convertValue(src, dst reflect.Value, path string) {
+	if src.Type().AssignableTo(dst.Type()) {
+		dst.Set(src)
+		return
+	}
+
+	errs := c.errs
+	switch srcOption, dstOption := isOption(src.Type()), isOption(dst.Type()); {
+	case c.toOptions && srcOption && !dstOption:
+		*errs = append(*errs, &FieldError{Path: path, Err: fmt.Errorf("cannot convert %s out of an Option; use Deoptionify", src.Type())})
+		return
+	case !c.toOptions && dstOption && !srcOption:
+		*errs = append(*errs, &FieldError{Path: path, Err: fmt.Errorf("cannot convert %s into an Option; use Optionify", src.Type())})
+		return
+	}
+
+	value, present := optionalValue(src)
+	switch {
+	case isOptionalType(dst.Type()):
+		dst.SetZero()
+		if !present {
+			return
+		}
+		inner := reflect.New(optionalElem(dst.Type())).Elem()
+		before := len(*errs)
+		c.convertValue(value, inner, path)
+		if len(*errs) == before {
+			setOptional(dst, inner)
+		}
+		return
+	case !present:
+		*errs = append(*errs, &FieldError{Path: path, Err: errors.New("value is required")})
+		return
+	case value.Type().AssignableTo(dst.Type()):
+		dst.Set(value)
+		return
+	}
+
+	switch {
+	case value.Kind() == reflect.Struct && dst.Kind() == reflect.Struct:
+		dstFields := map[string][]int{}
+		for _, f := range taggedFields(dst.Type(), "json", nil, nil) {
+			dstFields[strings.ToLower(f.name)] = f.index
+		}
+		for _, f := range taggedFields(value.Type(), "json", nil, nil) {
+			if index, ok := dstFields[strings.ToLower(f.name)]; ok {
+				c.convertValue(value.FieldByIndex(f.index), dst.FieldByIndex(index), joinPath(path, f.name))
+			}
+		}
+	case value.Kind() == reflect.Slice && dst.Kind() == reflect.Slice:
+		if value.IsNil() {
+			dst.SetZero()
+			return
+		}
+		slice := reflect.MakeSlice(dst.Type(), value.Len(), value.Len())
+		for i := range value.Len() {
+			c.convertValue(value.Index(i), slice.Index(i), fmt.Sprintf("%s[%d]", path, i))
+		}
+		dst.Set(slice)
+	default:
+		if err := convertScalar(value, dst); err != nil {
+			*errs = append(*errs, &FieldError{Path: path, Err: err})
+		}
+	}
+}
+
+// convertScalar stores a number, string or bool in dst, whose type may differ from the
+// value's as long as both are of the same kind and the value fits.
+func convertScalar(v, dst reflect.Value) error {
+	switch {
+	case isInt(v.Kind()) && isInt(dst.Kind()):
+		n := v.Int()
+		if dst.OverflowInt(n) {
+			return fmt.Errorf("%d overflows %s", n, dst.Type())
+		}
+		dst.SetInt(n)
+	case isUint(v.Kind()) && isUint(dst.Kind()):
+		n := v.Uint()
+		if dst.OverflowUint(n) {
+			return fmt.Errorf("%d overflows %s", n, dst.Type())
+		}
+		dst.SetUint(n)
+	case isInt(v.Kind()) && isUint(dst.Kind()):
+		n := v.Int()
+		if n < 0 || dst.OverflowUint(uint64(n)) {
+			return fmt.Errorf("%d overflows %s", n, dst.Type())
+		}
+		dst.SetUint(uint64(n))
+	case isUint(v.Kind()) && isInt(dst.Kind()):
+		n := v.Uint()
+		if int64(n) < 0 || dst.OverflowInt(int64(n)) {
+			return fmt.Errorf("%d overflows %s", n, dst.Type())
+		}
+		dst.SetInt(int64(n))
+	case isFloat(v.Kind()) && isFloat(dst.Kind()):
+		if dst.OverflowFloat(v.Float()) {
+			return fmt.Errorf("%g overflows %s", v.Float(), dst.Type())
+		}
+		dst.SetFloat(v.Float())
+	case (isInt(v.Kind()) || isUint(v.Kind())) && isFloat(dst.Kind()),
+		v.Kind() == reflect.String && dst.Kind() == reflect.String,
+		v.Kind() == reflect.Bool && dst.Kind() == reflect.Bool:
+		dst.Set(v.Convert(dst.Type()))
+	default:
+		return fmt.Errorf("cannot convert %s to %s", v.Type(), dst.Type())
+	}
+	return nil
+}
+
+func isInt(k reflect.Kind) bool   { return k >= reflect.Int && k <= reflect.Int64 }
+func isUint(k reflect.Kind) bool  { return k >= reflect.Uint && k <= reflect.Uintptr }
+func isFloat(k reflect.Kind) bool { return k == reflect.Float32 || k == reflect.Float64 }
+
+// isSQLNull reports whether t is one of the database/sql Null types, such as
+// sql.NullString or sql.Null[T], whose value is their first field.
+func isSQLNull(t reflect.Type) bool {
+	if t.Kind() != reflect.Struct || t.PkgPath() != "database/sql" || !strings.HasPrefix(t.Name(), "Null") {
+		return false
+	}
+	valid, ok := t.FieldByName("Valid")
+	return ok && valid.Type.Kind() == reflect.Bool && t.NumField() == 2
+}
+
+// isOption reports whether t is an Option.
+func isOption(t reflect.Type) bool {
+	_, ok := reflect.New(t).Interface().(optionValue)
+	return ok
+}
+
+// isOptionalType reports whether t can be empty: an Option, a pointer or a database/sql
+// Null type.
+func isOptionalType(t reflect.Type) bool {
+	return isOption(t) || t.Kind() == reflect.Pointer || isSQLNull(t)
+}
+
+// optionalElem returns the type of the value held by the optional type t.
+func optionalElem(t reflect.Type) reflect.Type {
+	if o, ok := reflect.New(t).Interface().(optionValue); ok {
+		return o.elemType()
+	}
+	if t.Kind() == reflect.Pointer {
+		return t.Elem()
+	}
+	return t.Field(0).Type
+}
+
+// optionalValue returns the value held by v and whether it is present. Values that are
+// not of an optional type are always present.
+func optionalValue(v reflect.Value) (reflect.Value, bool) {
+	switch {
+	case v.Kind() == reflect.Pointer:
+		if v.IsNil() {
+			return reflect.Value{}, false
+		}
+		return v.Elem(), true
+	case isSQLNull(v.Type()):
+		return v.Field(0), v.FieldByName("Valid").Bool()
+	}
+	p := reflect.New(v.Type())
+	if o, ok := p.Interface().(optionValue); ok {
+		p.Elem().Set(v)
+		return o.someValue()
+	}
+	return v, true
+}
+
+// setOptional stores inner in dst, an empty value of an optional type.
+func setOptional(dst, inner reflect.Value) {
+	if o, ok := dst.Addr().Interface().(optionValue); ok {
+		o.setSome(inner)
+		return
+	}
+	if dst.Kind() == reflect.Pointer {
+		p := reflect.New(inner.Type())
+		p.Elem().Set(inner)
+		dst.Set(p)
+		return
+	}
+	dst.Field(0).Set(inner)
+	dst.FieldByName("Valid").SetBool(true)
+}
@@ -0,0 +1,115 @@
+package jagain
+
+import (
+	"database/sql"
+	"strings"
+	"testing"
+	"time"
+)
+
+type userRow struct {
+	ID        int64
+	Nickname  sql.NullString
+	Manager   *int64
+	Score     sql.Null[int32]
+	CreatedAt sql.NullTime
+	Tags      []string
+	Address   *addressRow
+}
+
+type addressRow struct {
+	City string
+	Zip  *string
+}
+
+type userDTO struct {
+	ID        int             `json:"id"`
+	Nickname  Option[string]  `json:"nickname"`
+	Manager   Option[int64]   `json:"manager"`
+	Score     Option[float64] `json:"score"`
+	CreatedAt Option[time.Time]
+	Tags      []string           `json:"tags"`
+	Address   Option[addressDTO] `json:"address"`
+	Extra     Option[string]     `json:"extra"`
+}
+
+type addressDTO struct {
+	City string         `json:"city"`
+	Zip  Option[string] `json:"zip"`
+}
+
+func TestOptionify(t *testing.T) {
+	manager, zip := int64(3), "97201"
+	created := time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC)
+	row := userRow{
+		ID:        7,
+		Nickname:  sql.NullString{String: "jj", Valid: true},
+		Manager:   &manager,
+		Score:     sql.Null[int32]{},
+		CreatedAt: sql.NullTime{Time: created, Valid: true},
+		Tags:      []string{"admin"},
+		Address:   &addressRow{City: "Portland", Zip: &zip},
+	}
+
+	// Test converting pointers and Null types into Options
+	dto := Optionify[userDTO](row)
+	if dto.IsErr() {
+		t.Fatalf("Failed to optionify: %v", dto.UnwrapErr())
+	}
+	u := dto.Unwrap()
+	if u.ID != 7 || u.Nickname.UnwrapOr("") != "jj" || u.Manager.UnwrapOr(0) != 3 || !u.Score.IsNone() {
+		t.Errorf("Expected converted scalar fields, got %+v", u)
+	}
+	if !u.CreatedAt.UnwrapOr(time.Time{}).Equal(created) || len(u.Tags) != 1 || !u.Extra.IsNone() {
+		t.Errorf("Expected converted time and tags, got %+v", u)
+	}
+	if a := u.Address.UnwrapOr(addressDTO{}); a.City != "Portland" || a.Zip.UnwrapOr("") != "97201" {
+		t.Errorf("Expected nested address to convert, got %+v", u.Address)
+	}
+
+	// Test converting back
+	back := Deoptionify[userRow](u)
+	if back.IsErr() {
+		t.Fatalf("Failed to deoptionify: %v", back.UnwrapErr())
+	}
+	r := back.Unwrap()
+	if r.ID != 7 || r.Nickname != row.Nickname || *r.Manager != 3 || r.Score.Valid || r.CreatedAt != row.CreatedAt {
+		t.Errorf("Expected round trip, got %+v", r)
+	}
+	if r.Address == nil || r.Address.City != "Portland" || *r.Address.Zip != "97201" {
+		t.Errorf("Expected nested address to round trip, got %+v", r.Address)
+	}
+	if r.Manager == row.Manager {
+		t.Errorf("Expected pointers to be freshly allocated")
+	}
+}
+
+func TestOptionifyErrors(t *testing.T) {
+	type wide struct {
+		Count Option[int64]
+		City  Option[string]
+		Name  bool
+	}
+	type narrow struct {
+		Count *int8
+		City  string
+		Name  string
+	}
+	got := Deoptionify[narrow](wide{Count: Some(int64(300)), Name: true})
+	if !got.IsErr() {
+		t.Fatalf("Expected conversion errors, got %v", got)
+	}
+	msg := got.UnwrapErr().Error()
+	for _, want := range []string{"Count: 300 overflows int8", "City: value is required", "Name: cannot convert bool to string"} {
+		if !strings.Contains(msg, want) {
+			t.Errorf("Expected %q in:\n%s", want, msg)
+		}
+	}
+	// Test conversions in the wrong direction
+	if got := Optionify[narrow](wide{Count: Some(int64(1)), City: Some("x")}); !got.IsErr() || !strings.Contains(got.UnwrapErr().Error(), "use Deoptionify") {
+		t.Errorf("Expected Optionify to reject Options in the source, got %v", got)
+	}
+	if got := Deoptionify[wide](narrow{City: "x"}); !got.IsErr() || !strings.Contains(got.UnwrapErr().Error(), "use Optionify") {
+		t.Errorf("Expected Deoptionify to reject Options in the destination, got %v", got)
+	}
+}
@@ -0,0 +1,100 @@
+package jagain
+
+import (
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"reflect"
+)
+
+// ReadCSV decodes CSV records into a slice of T. The first record is the header; columns
+// are matched to struct fields by their `csv` tag, or by the field name when the tag is
+// absent, and fields tagged `csv:"-"` are ignored. Cells are decoded as text, so an empty
+// cell becomes None in an Option field and an error in a plain numeric field.
+func ReadCSV[T any](r io.Reader) Result[[]T] {
+	t := reflect.TypeFor[T]()
+	if t.Kind() != reflect.Struct {
+		return Err[[]T](fmt.Errorf("read csv: %s is not a struct", t))
+	}
+
+	cr := csv.NewReader(r)
+	header, err := cr.Read()
+	if errors.Is(err, io.EOF) {
+		return Ok([]T{})
+	}
+	if err != nil {
+		return Err[[]T](fmt.Errorf("read csv: %w", err))
+	}
+
+	fields := map[string][]int{}
+	for _, f := range taggedFields(t, "csv", nil, nil) {
+		fields[f.name] = f.index
+	}
+	indexes := make([][]int, len(header))
+	for i, col := range header {
+		index, ok := fields[col]
+		if !ok {
+			return Err[[]T](fmt.Errorf("read csv: no field in %s for column %q", t, col))
+		}
+		indexes[i] = index
+	}
+
+	items := []T{}
+	for {
+		record, err := cr.Read()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return Err[[]T](fmt.Errorf("read csv: %w", err))
+		}
+
+		var item T
+		v := reflect.ValueOf(&item).Elem()
+		for i, cell := range record {
+			field := v.FieldByIndex(indexes[i])
+			if err := unmarshalText([]byte(cell), field.Addr().Interface()); err != nil {
+				line, _ := cr.FieldPos(i)
+				return Err[[]T](fmt.Errorf("read csv: line %d, column %q: %w", line, header[i], err))
+			}
+		}
+		items = append(items, item)
+	}
+	return Ok(items)
+}
+
+// WriteCSV encodes items as CSV with a header record, using the same field mapping as
+// ReadCSV. None is written as an empty cell.
+func WriteCSV[T any](w io.Writer, items []T) error {
+	t := reflect.TypeFor[T]()
+	if t.Kind() != reflect.Struct {
+		return fmt.Errorf("write csv: %s is not a struct", t)
+	}
+
+	fields := taggedFields(t, "csv", nil, nil)
+	record := make([]string, len(fields))
+	for i, f := range fields {
+		record[i] = f.name
+	}
+
+	cw := csv.NewWriter(w)
+	if err := cw.Write(record); err != nil {
+		return fmt.Errorf("write csv: %w", err)
+	}
+	for _, item := range items {
+		v := reflect.ValueOf(item)
+		for i, f := range fields {
+			text, err := marshalText(v.FieldByIndex(f.index).Interface())
+			if err != nil {
+				return fmt.Errorf("write csv: column %q: %w", f.name, err)
+			}
+			record[i] = string(text)
+		}
+		if err := cw.Write(record); err != nil {
+			return fmt.Errorf("write csv: %w", err)
+		}
+	}
+	cw.Flush()
+	return cw.Error()
+}
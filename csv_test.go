@@ -0,0 +1,68 @@
+package jagain
+
+import (
+	"strings"
+	"testing"
+)
+
+type csvAudit struct {
+	Source string `csv:"source"`
+}
+
+type csvContact struct {
+	Name  string          `csv:"name"`
+	Email Option[string]  `csv:"email"`
+	Age   Option[int]     `csv:"age"`
+	Score Option[float64] `csv:"score"`
+	Notes string          `csv:"-"`
+	csvAudit
+}
+
+func TestCSV(t *testing.T) {
+	// Test ReadCSV
+	input := "name,email,age,score,source\n" +
+		"Jane,jane@example.com,30,,import\n" +
+		"John,,,1.5,manual\n"
+	contacts := ReadCSV[csvContact](strings.NewReader(input))
+	if contacts.IsErr() {
+		t.Fatalf("Failed to read CSV: %v", contacts.UnwrapErr())
+	}
+	rows := contacts.Unwrap()
+	if len(rows) != 2 {
+		t.Fatalf("Expected 2 rows, got %d", len(rows))
+	}
+	if rows[0].Email.UnwrapOr("") != "jane@example.com" || rows[0].Age.UnwrapOr(0) != 30 || !rows[0].Score.IsNone() {
+		t.Errorf("Expected first row to decode, got %+v", rows[0])
+	}
+	if !rows[1].Email.IsNone() || !rows[1].Age.IsNone() || rows[1].Score.UnwrapOr(0) != 1.5 {
+		t.Errorf("Expected empty cells to be None, got %+v", rows[1])
+	}
+	if rows[1].Source != "manual" {
+		t.Errorf("Expected embedded field to be filled, got %q", rows[1].Source)
+	}
+
+	// Test ReadCSV errors
+	bad := ReadCSV[csvContact](strings.NewReader("name,age\nJane,old\n"))
+	if !bad.IsErr() || !strings.Contains(bad.UnwrapErr().Error(), `line 2, column "age"`) {
+		t.Errorf("Expected invalid cell to report its position, got %v", bad)
+	}
+	if ReadCSV[csvContact](strings.NewReader("name,phone\n")).IsOk() {
+		t.Errorf("Expected unknown column to fail")
+	}
+
+	// Test WriteCSV
+	var sb strings.Builder
+	err := WriteCSV(&sb, []csvContact{
+		{Name: "Jane", Email: Some("jane@example.com"), Age: Some(30), csvAudit: csvAudit{"import"}},
+		{Name: "John", Score: Some(1.5)},
+	})
+	if err != nil {
+		t.Fatalf("Failed to write CSV: %v", err)
+	}
+	want := "name,email,age,score,source\n" +
+		"Jane,jane@example.com,30,,import\n" +
+		"John,,,1.5,\n"
+	if sb.String() != want {
+		t.Errorf("Expected CSV:\n%s\ngot:\n%s", want, sb.String())
+	}
+}
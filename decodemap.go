@@ -0,0 +1,205 @@
+package jagain
+
+import (
+	"encoding"
+	"fmt"
+	"math"
+	"reflect"
+	"strconv"
+	"strings"
+)
+
+// DecodeMap decodes m, such as dynamic configuration, a webhook payload or a document
+// from a NoSQL store, into a T. Keys are matched to struct fields by their `json` tag, or
+// by the field name when the tag is absent, falling back to a case-insensitive match;
+// fields tagged `json:"-"` are ignored, and so are keys that match no field. A missing
+// key or a nil value leaves an Option field None and any other field zero.
+//
+// Conversion is weak, so loosely typed input decodes without a schema:
+//
+//   - strings are parsed into numbers, bools and encoding.TextUnmarshaler types, and an
+//     empty string is zero for numbers and bools;
+//   - numbers and bools are formatted into strings, and bools become 1 or 0;
+//   - floats without a fractional part, such as numbers decoded from JSON, fill integer
+//     fields as long as they fit;
+//   - a single value fills a slice of one element.
+//
+// Nested maps and slices are decoded the same way, and every conversion failure is
+// reported in the returned ValidationErrors with the path of its field.
+func DecodeMap[T any](m map[string]any) Result[T] {
+	var item T
+	var errs ValidationErrors
+	decodeMapValue(m, reflect.ValueOf(&item).Elem(), "", &errs)
+	if len(errs) > 0 {
+		return Err[T](errs)
+	}
+	return Ok(item)
+}
+
+// decodeMapValue stores src in v, appending a FieldError for every problem under path.
+func decodeMapValue(src any, v reflect.Value, path string, errs *ValidationErrors) {
+	if src == nil {
+		v.SetZero()
+		return
+	}
+	fail := func(err error) {
+		*errs = append(*errs, &FieldError{Path: path, Err: err})
+	}
+
+	if o, ok := v.Addr().Interface().(optionValue); ok {
+		inner := reflect.New(o.elemType()).Elem()
+		before := len(*errs)
+		decodeMapValue(src, inner, path, errs)
+		if len(*errs) == before {
+			o.setSome(inner)
+		}
+		return
+	}
+	sv := reflect.ValueOf(src)
+	if sv.Type().AssignableTo(v.Type()) {
+		v.Set(sv)
+		return
+	}
+	if s, ok := src.(string); ok {
+		if _, isText := v.Addr().Interface().(encoding.TextUnmarshaler); isText {
+			if err := unmarshalText([]byte(s), v.Addr().Interface()); err != nil {
+				fail(err)
+			}
+			return
+		}
+	}
+
+	switch v.Kind() {
+	case reflect.Pointer:
+		p := reflect.New(v.Type().Elem())
+		before := len(*errs)
+		decodeMapValue(src, p.Elem(), path, errs)
+		if len(*errs) == before {
+			v.Set(p)
+		}
+	case reflect.Struct:
+		if sv.Kind() != reflect.Map || sv.Type().Key().Kind() != reflect.String {
+			fail(fmt.Errorf("expected a map for %s, got %T", v.Type(), src))
+			return
+		}
+		for _, f := range taggedFields(v.Type(), "json", nil, nil) {
+			if value, ok := mapKey(sv, f.name); ok {
+				decodeMapValue(value, v.FieldByIndex(f.index), joinPath(path, f.name), errs)
+			}
+		}
+	case reflect.Map:
+		if sv.Kind() != reflect.Map {
+			fail(fmt.Errorf("expected a map for %s, got %T", v.Type(), src))
+			return
+		}
+		m := reflect.MakeMapWithSize(v.Type(), sv.Len())
+		for iter := sv.MapRange(); iter.Next(); {
+			name := fmt.Sprint(iter.Key().Interface())
+			key := reflect.New(v.Type().Key()).Elem()
+			elem := reflect.New(v.Type().Elem()).Elem()
+			decodeMapValue(iter.Key().Interface(), key, joinPath(path, name), errs)
+			decodeMapValue(iter.Value().Interface(), elem, joinPath(path, name), errs)
+			m.SetMapIndex(key, elem)
+		}
+		v.Set(m)
+	case reflect.Slice:
+		if s, ok := src.(string); ok && v.Type().Elem().Kind() == reflect.Uint8 {
+			v.SetBytes([]byte(s))
+			return
+		}
+		if sv.Kind() != reflect.Slice && sv.Kind() != reflect.Array {
+			slice := reflect.MakeSlice(v.Type(), 1, 1)
+			decodeMapValue(src, slice.Index(0), fmt.Sprintf("%s[0]", path), errs)
+			v.Set(slice)
+			return
+		}
+		slice := reflect.MakeSlice(v.Type(), sv.Len(), sv.Len())
+		for i := range sv.Len() {
+			decodeMapValue(sv.Index(i).Interface(), slice.Index(i), fmt.Sprintf("%s[%d]", path, i), errs)
+		}
+		v.Set(slice)
+	default:
+		if err := weakScalar(sv, v); err != nil {
+			fail(err)
+		}
+	}
+}
+
+// mapKey returns the value of m stored under name, falling back to a key that matches
+// name case-insensitively.
+func mapKey(m reflect.Value, name string) (any, bool) {
+	if value := m.MapIndex(reflect.ValueOf(name).Convert(m.Type().Key())); value.IsValid() {
+		return value.Interface(), true
+	}
+	for iter := m.MapRange(); iter.Next(); {
+		if strings.EqualFold(iter.Key().String(), name) {
+			return iter.Value().Interface(), true
+		}
+	}
+	return nil, false
+}
+
+// weakScalar stores the string, bool or number src in v, converting between them.
+func weakScalar(src, v reflect.Value) error {
+	switch {
+	case src.Kind() == reflect.String:
+		if src.Len() == 0 && (isInt(v.Kind()) || isUint(v.Kind()) || isFloat(v.Kind()) || v.Kind() == reflect.Bool) {
+			v.SetZero()
+			return nil
+		}
+		return unmarshalText([]byte(src.String()), v.Addr().Interface())
+	case src.Kind() == reflect.Bool:
+		switch {
+		case v.Kind() == reflect.String:
+			v.SetString(strconv.FormatBool(src.Bool()))
+			return nil
+		case isInt(v.Kind()) || isUint(v.Kind()) || isFloat(v.Kind()):
+			var n int64
+			if src.Bool() {
+				n = 1
+			}
+			return convertScalar(reflect.ValueOf(n), v)
+		}
+	case isInt(src.Kind()) || isUint(src.Kind()) || isFloat(src.Kind()):
+		switch {
+		case v.Kind() == reflect.String:
+			v.SetString(formatNumber(src))
+			return nil
+		case v.Kind() == reflect.Bool:
+			v.SetBool(numberOf(src) != 0)
+			return nil
+		case isFloat(src.Kind()) && (isInt(v.Kind()) || isUint(v.Kind())):
+			f := src.Float()
+			if f != math.Trunc(f) {
+				return fmt.Errorf("%g is not an integer", f)
+			}
+			if f < math.MinInt64 || f >= math.MaxInt64 {
+				return fmt.Errorf("%g overflows %s", f, v.Type())
+			}
+			return convertScalar(reflect.ValueOf(int64(f)), v)
+		}
+	}
+	return convertScalar(src, v)
+}
+
+// formatNumber formats the integer, unsigned or float number n in decimal.
+func formatNumber(n reflect.Value) string {
+	switch {
+	case isInt(n.Kind()):
+		return strconv.FormatInt(n.Int(), 10)
+	case isUint(n.Kind()):
+		return strconv.FormatUint(n.Uint(), 10)
+	}
+	return strconv.FormatFloat(n.Float(), 'f', -1, 64)
+}
+
+// numberOf returns the integer, unsigned or float number n as a float64.
+func numberOf(n reflect.Value) float64 {
+	switch {
+	case isInt(n.Kind()):
+		return float64(n.Int())
+	case isUint(n.Kind()):
+		return float64(n.Uint())
+	}
+	return n.Float()
+}
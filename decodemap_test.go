@@ -0,0 +1,84 @@
+package jagain
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+	"time"
+)
+
+type webhookEvent struct {
+	ID       int64             `json:"id"`
+	Type     string            `json:"type"`
+	Live     bool              `json:"live"`
+	Amount   Option[float64]   `json:"amount"`
+	Note     Option[string]    `json:"note"`
+	Created  time.Time         `json:"created"`
+	Tags     []string          `json:"tags"`
+	Customer Option[customer]  `json:"customer"`
+	Metadata map[string]string `json:"metadata"`
+	Retries  *int              `json:"retries"`
+}
+
+type customer struct {
+	Email string      `json:"email"`
+	Age   Option[int] `json:"age"`
+}
+
+func TestDecodeMap(t *testing.T) {
+	var m map[string]any
+	payload := `{
+		"id": 42, "TYPE": "charge", "live": "true", "amount": "9.5", "note": null,
+		"created": "2024-01-02T03:04:05Z", "tags": "vip",
+		"customer": {"email": "jane@example.com", "age": 30},
+		"metadata": {"order": 1001, "gift": false}, "retries": "2", "unknown": 1
+	}`
+	if err := json.Unmarshal([]byte(payload), &m); err != nil {
+		t.Fatalf("Failed to parse payload: %v", err)
+	}
+
+	// Test weak conversion of a JSON document
+	decoded := DecodeMap[webhookEvent](m)
+	if decoded.IsErr() {
+		t.Fatalf("Failed to decode map: %v", decoded.UnwrapErr())
+	}
+	e := decoded.Unwrap()
+	if e.ID != 42 || e.Type != "charge" || !e.Live || e.Amount.UnwrapOr(0) != 9.5 || !e.Note.IsNone() {
+		t.Errorf("Expected converted scalar fields, got %+v", e)
+	}
+	if !e.Created.Equal(time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC)) || len(e.Tags) != 1 || e.Tags[0] != "vip" {
+		t.Errorf("Expected time and single-value slice, got %+v", e)
+	}
+	if c := e.Customer.UnwrapOr(customer{}); c.Email != "jane@example.com" || c.Age.UnwrapOr(0) != 30 {
+		t.Errorf("Expected nested customer, got %+v", e.Customer)
+	}
+	if e.Metadata["order"] != "1001" || e.Metadata["gift"] != "false" || e.Retries == nil || *e.Retries != 2 {
+		t.Errorf("Expected metadata and retries, got %v %v", e.Metadata, e.Retries)
+	}
+
+	// Test missing keys
+	decoded = DecodeMap[webhookEvent](map[string]any{"id": 1})
+	if decoded.IsErr() || !decoded.Unwrap().Amount.IsNone() || !decoded.Unwrap().Customer.IsNone() || decoded.Unwrap().Retries != nil {
+		t.Errorf("Expected missing keys to be None, got %v", decoded)
+	}
+
+	// Test that every conversion error is reported with its path
+	decoded = DecodeMap[webhookEvent](map[string]any{
+		"id":       1.5,
+		"live":     "maybe",
+		"customer": map[string]any{"age": "old"},
+		"tags":     []any{"a", map[string]any{}},
+	})
+	if !decoded.IsErr() {
+		t.Fatalf("Expected conversion errors")
+	}
+	msg := decoded.UnwrapErr().Error()
+	for _, want := range []string{"id: 1.5 is not an integer", "live: ", "customer.age: ", "tags[1]: "} {
+		if !strings.Contains(msg, want) {
+			t.Errorf("Expected %q in:\n%s", want, msg)
+		}
+	}
+	if len(ValidationErrorsOf(decoded.UnwrapErr())) != 4 {
+		t.Errorf("Expected 4 errors, got:\n%s", msg)
+	}
+}
@@ -53,8 +53,8 @@ func UserFromDTO(dto UserDTO) User {
 	}
 }
 
-// ExampleEmail demonstrates using Option for email validation
-func ExampleEmail() {
+// Example_email demonstrates using Option for email validation
+func Example_email() {
 	// Valid email
 	email := "user@example.com"
 	validEmailOpt := Some(email)
@@ -0,0 +1,64 @@
+package jagain
+
+import (
+	"reflect"
+	"strings"
+)
+
+type taggedField struct {
+	name    string
+	options string
+	index   []int
+}
+
+// taggedFields lists the exported fields of t in declaration order, named by the struct
+// tag key or by the field name when the tag has no name. Options after a comma in the tag
+// are kept separately, fields tagged "-" are skipped and untagged embedded structs are flattened.
+func taggedFields(t reflect.Type, key string, prefix []int, fields []taggedField) []taggedField {
+	for i := 0; i < t.NumField(); i++ {
+		f := t.Field(i)
+		tag := f.Tag.Get(key)
+		if tag == "-" {
+			continue
+		}
+		index := append(append([]int{}, prefix...), f.Index...)
+		if f.Anonymous && tag == "" && f.Type.Kind() == reflect.Struct {
+			fields = taggedFields(f.Type, key, index, fields)
+			continue
+		}
+		if !f.IsExported() {
+			continue
+		}
+
+		name, options, _ := strings.Cut(tag, ",")
+		if name == "" {
+			name = f.Name
+		}
+		fields = append(fields, taggedField{name: name, options: options, index: index})
+	}
+	return fields
+}
+
+// optionValue lets reflection-driven code read and fill an Option without knowing T.
+type optionValue interface {
+	elemType() reflect.Type
+	setSome(v reflect.Value)
+	someValue() (reflect.Value, bool)
+}
+
+func (o *Option[T]) elemType() reflect.Type {
+	return reflect.TypeFor[T]()
+}
+
+func (o *Option[T]) setSome(v reflect.Value) {
+	var value T
+	reflect.ValueOf(&value).Elem().Set(v)
+	*o = Some(value)
+}
+
+func (o *Option[T]) someValue() (reflect.Value, bool) {
+	if !o.valid {
+		return reflect.Value{}, false
+	}
+	return reflect.ValueOf(&o.value).Elem(), true
+}
@@ -0,0 +1,92 @@
+package jagain
+
+import "errors"
+
+// badKey is the key used for a value passed to WithFields without a string key,
+// matching the convention of log/slog.
+const badKey = "!BADKEY"
+
+// fieldsError attaches key-value metadata to an error.
+type fieldsError struct {
+	fields map[string]any
+	err    error
+}
+
+func (e *fieldsError) Error() string {
+	return e.err.Error()
+}
+
+func (e *fieldsError) Unwrap() error {
+	return e.err
+}
+
+// WithFields attaches key-value metadata, such as a request or entity ID, to err without
+// changing its message. Arguments alternate between string keys and values, as with
+// log/slog. The metadata survives wrapping with %w and is read back with ErrorFields.
+// A nil err is returned unchanged.
+func WithFields(err error, kv ...any) error {
+	if err == nil {
+		return nil
+	}
+	return &fieldsError{fields: pairs(kv), err: err}
+}
+
+// ErrWith creates a Result containing err annotated with key-value metadata.
+func ErrWith[T any](err error, kv ...any) Result[T] {
+	return Err[T](WithFields(err, kv...))
+}
+
+// WithField adds a key-value pair to the Result's error metadata.
+// If the Result contains a success value, it is returned unchanged.
+func (r Result[T]) WithField(key string, value any) Result[T] {
+	if r.valid {
+		return r
+	}
+	return errResult[T](WithFields(r.err, key, value))
+}
+
+// ErrorFields collects the metadata attached to err and the errors it wraps.
+// When a key appears more than once, the outermost value wins. It returns nil when
+// there is no metadata.
+func ErrorFields(err error) map[string]any {
+	var fields map[string]any
+	for err != nil {
+		var fe *fieldsError
+		if !errors.As(err, &fe) {
+			break
+		}
+		if fields == nil {
+			fields = make(map[string]any, len(fe.fields))
+		}
+		for k, v := range fe.fields {
+			if _, ok := fields[k]; !ok {
+				fields[k] = v
+			}
+		}
+		err = fe.err
+	}
+	return fields
+}
+
+// Fields returns the metadata attached to an Err result, or nil for an Ok result.
+func Fields[T any](r Result[T]) map[string]any {
+	if r.valid {
+		return nil
+	}
+	return ErrorFields(r.err)
+}
+
+// pairs converts alternating keys and values into a map.
+func pairs(kv []any) map[string]any {
+	fields := make(map[string]any, len(kv)/2)
+	for i := 0; i < len(kv); i++ {
+		key, ok := kv[i].(string)
+		if !ok || i+1 == len(kv) {
+			fields[badKey] = kv[i]
+			continue
+		}
+		fields[key] = kv[i+1]
+		i++
+	}
+	return fields
+}
@@ -0,0 +1,41 @@
+package jagain
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+)
+
+func TestFields(t *testing.T) {
+	errTimeout := errors.New("timeout")
+
+	// Test ErrWith and WithField
+	r := ErrWith[int](errTimeout, "requestID", "abc", "attempt", 2).WithField("attempt", 3)
+	fields := Fields(r)
+	if fields["requestID"] != "abc" || fields["attempt"] != 3 {
+		t.Errorf("Expected outer fields to win, got %v", fields)
+	}
+	if r.UnwrapErr().Error() != "timeout" || !errors.Is(r.UnwrapErr(), errTimeout) {
+		t.Errorf("Expected metadata to leave the error unchanged, got %v", r.UnwrapErr())
+	}
+
+	// Test that metadata survives wrapping
+	wrapped := r.Wrap("fetch").MapErr(func(err error) error { return fmt.Errorf("sync: %w", err) })
+	if Fields(wrapped)["requestID"] != "abc" {
+		t.Errorf("Expected metadata to survive wrapping, got %v", Fields(wrapped))
+	}
+
+	// Test malformed pairs
+	fields = ErrorFields(WithFields(errTimeout, 42, "orphan"))
+	if fields[badKey] != "orphan" || len(fields) != 1 {
+		t.Errorf("Expected malformed pairs to use %s, got %v", badKey, fields)
+	}
+
+	// Test results without metadata
+	if Fields(Ok(1)) != nil || Fields(Err[int](errTimeout)) != nil {
+		t.Errorf("Expected no metadata")
+	}
+	if !Ok(1).WithField("k", "v").IsOk() {
+		t.Errorf("Expected WithField on Ok to be a no-op")
+	}
+}
@@ -0,0 +1,58 @@
+package jagain
+
+import (
+	"io/fs"
+	"os"
+)
+
+// ReadFileR reads the named file, like os.ReadFile.
+func ReadFileR(path string) Result[[]byte] {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return Err[[]byte](err)
+	}
+	return Ok(data)
+}
+
+// WriteFileR writes data to the named file, like os.WriteFile.
+func WriteFileR(path string, data []byte, perm fs.FileMode) Result[struct{}] {
+	if err := os.WriteFile(path, data, perm); err != nil {
+		return Err[struct{}](err)
+	}
+	return Ok(struct{}{})
+}
+
+// OpenR opens the named file for reading, like os.Open. The caller must close the file;
+// WithFile does so automatically.
+func OpenR(path string) Result[*os.File] {
+	f, err := os.Open(path)
+	if err != nil {
+		return Err[*os.File](err)
+	}
+	return Ok(f)
+}
+
+// StatOption returns information about the named file, or None if it cannot be
+// stat'ed, typically because it does not exist.
+func StatOption(path string) Option[fs.FileInfo] {
+	info, err := os.Stat(path)
+	if err != nil {
+		return None[fs.FileInfo]()
+	}
+	return Some(info)
+}
+
+// WithFile opens the named file for reading, passes it to f and closes it once f returns,
+// even if f panics. An error from closing the file turns an Ok result into an Err.
+func WithFile[T any](path string, f func(*os.File) Result[T]) (result Result[T]) {
+	file, err := os.Open(path)
+	if err != nil {
+		return Err[T](err)
+	}
+	defer func() {
+		if err := file.Close(); err != nil && result.valid {
+			result = Err[T](err)
+		}
+	}()
+	return f(file)
+}
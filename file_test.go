@@ -0,0 +1,78 @@
+package jagain
+
+import (
+	"errors"
+	"io"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestFileHelpers(t *testing.T) {
+	dir := t.TempDir()
+	path := filepath.Join(dir, "data.txt")
+
+	// Test WriteFileR and ReadFileR
+	if r := WriteFileR(path, []byte("hello"), 0o600); r.IsErr() {
+		t.Fatalf("Failed to write: %v", r.UnwrapErr())
+	}
+	if got := ReadFileR(path); string(got.UnwrapOr(nil)) != "hello" {
+		t.Errorf("Expected to read hello, got %v", got)
+	}
+	if r := ReadFileR(filepath.Join(dir, "missing")); !errors.Is(r.UnwrapErr(), fs.ErrNotExist) {
+		t.Errorf("Expected a not-exist error, got %v", r)
+	}
+	if r := WriteFileR(filepath.Join(dir, "no", "such", "dir"), nil, 0o600); r.IsOk() {
+		t.Errorf("Expected writing into a missing directory to fail")
+	}
+
+	// Test OpenR
+	f := OpenR(path)
+	if f.IsErr() {
+		t.Fatalf("Failed to open: %v", f.UnwrapErr())
+	}
+	f.Unwrap().Close()
+
+	// Test StatOption
+	if info := StatOption(path); info.IsNone() || info.Unwrap().Size() != 5 {
+		t.Errorf("Expected Some with size 5, got %v", info)
+	}
+	if info := StatOption(filepath.Join(dir, "missing")); !info.IsNone() {
+		t.Errorf("Expected None for a missing file, got %v", info)
+	}
+
+	// Test WithFile closes the file
+	var opened *os.File
+	r := WithFile(path, func(f *os.File) Result[string] {
+		opened = f
+		data, err := io.ReadAll(f)
+		if err != nil {
+			return Err[string](err)
+		}
+		return Ok(string(data))
+	})
+	if r.UnwrapOr("") != "hello" {
+		t.Errorf("Expected Ok(hello), got %v", r)
+	}
+	if _, err := opened.Read(make([]byte, 1)); !errors.Is(err, os.ErrClosed) {
+		t.Errorf("Expected the file to be closed, got %v", err)
+	}
+
+	// Test WithFile closes the file when f panics
+	func() {
+		defer func() { recover() }()
+		WithFile(path, func(f *os.File) Result[int] {
+			opened = f
+			panic("boom")
+		})
+	}()
+	if _, err := opened.Read(make([]byte, 1)); !errors.Is(err, os.ErrClosed) {
+		t.Errorf("Expected the file to be closed after a panic, got %v", err)
+	}
+
+	// Test WithFile on a missing file
+	if r := WithFile(filepath.Join(dir, "missing"), func(*os.File) Result[int] { return Ok(1) }); r.IsOk() {
+		t.Errorf("Expected an error for a missing file")
+	}
+}
@@ -0,0 +1,54 @@
+package jagain
+
+import (
+	"fmt"
+	"reflect"
+)
+
+// OptionVar is a flag.Value holding an Option. It stays None until the flag is passed on
+// the command line, which lets a flag override configuration only when it was actually set:
+//
+//	var port jagain.OptionVar[int]
+//	flag.Var(&port, "port", "listen port")
+//	flag.Parse()
+//	cfg.Port = port.UnwrapOr(cfg.Port)
+//
+// Values are parsed as text, so T may be a string, number or boolean, or implement
+// encoding.TextUnmarshaler. An OptionVar[bool] can be passed without a value.
+type OptionVar[T any] struct {
+	Option[T]
+}
+
+// String implements the flag.Value interface.
+// None is rendered as empty text so that it is not printed as a default.
+func (v *OptionVar[T]) String() string {
+	if v == nil || !v.valid {
+		return ""
+	}
+	text, err := marshalText(v.value)
+	if err != nil {
+		return fmt.Sprint(v.value)
+	}
+	return string(text)
+}
+
+// Set implements the flag.Value interface.
+// Any value passed on the command line, including an empty one, results in Some.
+func (v *OptionVar[T]) Set(s string) error {
+	var value T
+	if err := unmarshalText([]byte(s), &value); err != nil {
+		return err
+	}
+	v.Option = Some(value)
+	return nil
+}
+
+// Get implements the flag.Getter interface and returns the underlying Option.
+func (v *OptionVar[T]) Get() any {
+	return v.Option
+}
+
+// IsBoolFlag lets an OptionVar[bool] be passed as -name without a value.
+func (v *OptionVar[T]) IsBoolFlag() bool {
+	return reflect.TypeFor[T]().Kind() == reflect.Bool
+}
@@ -0,0 +1,54 @@
+package jagain
+
+import (
+	"flag"
+	"io"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestOptionVar(t *testing.T) {
+	var port OptionVar[int]
+	var host OptionVar[string]
+	var verbose OptionVar[bool]
+	var timeout OptionVar[time.Duration]
+
+	fs := flag.NewFlagSet("test", flag.ContinueOnError)
+	fs.SetOutput(io.Discard)
+	fs.Var(&port, "port", "listen port")
+	fs.Var(&host, "host", "listen host")
+	fs.Var(&verbose, "v", "verbose output")
+	fs.Var(&timeout, "timeout", "request timeout")
+
+	// Test parsing passed and omitted flags
+	if err := fs.Parse([]string{"-port", "8080", "-v", "-host="}); err != nil {
+		t.Fatalf("Failed to parse flags: %v", err)
+	}
+	if port.UnwrapOr(0) != 8080 {
+		t.Errorf("Expected port to be Some(8080), got %v", port.Option)
+	}
+	if !host.IsSome() || host.Unwrap() != "" {
+		t.Errorf("Expected explicitly empty host to be Some(\"\"), got %v", host.Option)
+	}
+	if !verbose.UnwrapOr(false) {
+		t.Errorf("Expected bool flag without value to be Some(true), got %v", verbose.Option)
+	}
+	if !timeout.IsNone() {
+		t.Errorf("Expected omitted flag to be None, got %v", timeout.Option)
+	}
+
+	// Test String and Get
+	if port.String() != "8080" || timeout.String() != "" {
+		t.Errorf("Expected String to render the value, got %q and %q", port.String(), timeout.String())
+	}
+	if got, ok := fs.Lookup("port").Value.(flag.Getter).Get().(Option[int]); !ok || got.Unwrap() != 8080 {
+		t.Errorf("Expected Get to return the Option, got %v", got)
+	}
+
+	// Test invalid values
+	err := fs.Parse([]string{"-port", "http"})
+	if err == nil || !strings.Contains(err.Error(), "-port") {
+		t.Errorf("Expected invalid port to fail, got %v", err)
+	}
+}
@@ -0,0 +1,110 @@
+// Package fuzz provides round-trip checks and corpus seeding for fuzzing types that
+// contain jagain Options.
+//
+// A typical fuzz target seeds the corpus with a few values and checks that every input
+// that decodes also survives being encoded and decoded again:
+//
+//	func FuzzUser(f *testing.F) {
+//		fuzz.Seed(f, User{Name: "ann"})
+//		f.Fuzz(func(t *testing.T, data []byte) {
+//			fuzz.JSON[User](t, data)
+//		})
+//	}
+package fuzz
+
+import (
+	"bytes"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/dendianugerah/jagain"
+)
+
+// JSON checks that data, if it decodes as a JSON Option[T], encodes to a stable form:
+// decoding the encoding and encoding again gives the same bytes.
+// Input that does not decode is ignored.
+func JSON[T any](t *testing.T, data []byte) {
+	t.Helper()
+	roundTrip(t, "JSON", data, jagain.Option[T].MarshalJSON, func(data []byte, o *jagain.Option[T]) error {
+		return json.Unmarshal(data, o)
+	})
+}
+
+// Text checks the text encoding of Option[T] in the same way JSON checks its JSON encoding.
+func Text[T any](t *testing.T, data []byte) {
+	t.Helper()
+	roundTrip(t, "text", data, jagain.Option[T].MarshalText, func(data []byte, o *jagain.Option[T]) error {
+		return o.UnmarshalText(data)
+	})
+}
+
+// Binary checks the binary encoding of Option[T] in the same way JSON checks its JSON encoding.
+func Binary[T any](t *testing.T, data []byte) {
+	t.Helper()
+	roundTrip(t, "binary", data, jagain.Option[T].MarshalBinary, func(data []byte, o *jagain.Option[T]) error {
+		return o.UnmarshalBinary(data)
+	})
+}
+
+// All runs the JSON, text and binary checks on data.
+func All[T any](t *testing.T, data []byte) {
+	t.Helper()
+	JSON[T](t, data)
+	Text[T](t, data)
+	Binary[T](t, data)
+}
+
+// Common runs All for Options of strings, integers, floats, booleans and times.
+func Common(t *testing.T, data []byte) {
+	t.Helper()
+	All[string](t, data)
+	All[int64](t, data)
+	All[float64](t, data)
+	All[bool](t, data)
+	All[time.Time](t, data)
+}
+
+// Seed adds the JSON, text and binary encodings of None and of Some of each value to
+// the corpus of f. Values that have no text encoding are skipped for that format.
+func Seed[T any](f *testing.F, values ...T) {
+	f.Helper()
+	opts := []jagain.Option[T]{jagain.None[T]()}
+	for _, v := range values {
+		opts = append(opts, jagain.Some(v))
+	}
+	for _, o := range opts {
+		for _, marshal := range []func() ([]byte, error){
+			func() ([]byte, error) { return json.Marshal(o) },
+			o.MarshalText,
+			o.MarshalBinary,
+		} {
+			if data, err := marshal(); err == nil {
+				f.Add(data)
+			}
+		}
+	}
+}
+
+func roundTrip[T any](t *testing.T, format string, data []byte, marshal func(jagain.Option[T]) ([]byte, error), unmarshal func([]byte, *jagain.Option[T]) error) {
+	t.Helper()
+	var first jagain.Option[T]
+	if err := unmarshal(data, &first); err != nil {
+		return
+	}
+	encoded, err := marshal(first)
+	if err != nil {
+		t.Fatalf("Failed to encode %v decoded from %s %q: %v", first, format, data, err)
+	}
+	var second jagain.Option[T]
+	if err := unmarshal(encoded, &second); err != nil {
+		t.Fatalf("Failed to decode %s %q encoded from %v: %v", format, encoded, first, err)
+	}
+	again, err := marshal(second)
+	if err != nil {
+		t.Fatalf("Failed to encode %v decoded from %s %q: %v", second, format, encoded, err)
+	}
+	if !bytes.Equal(encoded, again) {
+		t.Fatalf("Expected %s %q to round-trip, got %q", format, encoded, again)
+	}
+}
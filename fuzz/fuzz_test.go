@@ -0,0 +1,28 @@
+package fuzz
+
+import (
+	"testing"
+	"time"
+)
+
+type address struct {
+	City string `json:"city"`
+	Zip  int    `json:"zip"`
+}
+
+func FuzzCommon(f *testing.F) {
+	Seed(f, "hello", "")
+	Seed(f, int64(-42))
+	Seed(f, 1.5)
+	Seed(f, true)
+	Seed(f, time.Date(2024, 5, 1, 12, 0, 0, 0, time.UTC))
+	f.Fuzz(Common)
+}
+
+func FuzzStruct(f *testing.F) {
+	Seed(f, address{City: "Oslo", Zip: 150})
+	f.Fuzz(func(t *testing.T, data []byte) {
+		JSON[address](t, data)
+		Binary[address](t, data)
+	})
+}
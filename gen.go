@@ -0,0 +1,25 @@
+package jagain
+
+import (
+	"fmt"
+	"math/rand"
+)
+
+// GenOption generates an Option from r for property tests, using gen to produce the value.
+// One in four generated Options is None. Libraries such as gopter expose their random
+// source as a *rand.Rand, so gen can wrap an existing generator.
+func GenOption[T any](r *rand.Rand, gen func(*rand.Rand) T) Option[T] {
+	if r.Intn(4) == 0 {
+		return None[T]()
+	}
+	return Some(gen(r))
+}
+
+// GenResult generates a Result from r for property tests, using gen to produce the value.
+// One in four generated Results is an Err holding a generated error.
+func GenResult[T any](r *rand.Rand, gen func(*rand.Rand) T) Result[T] {
+	if r.Intn(4) == 0 {
+		return Err[T](fmt.Errorf("generated error %d", r.Intn(1000)))
+	}
+	return Ok(gen(r))
+}
@@ -0,0 +1,35 @@
+package jagain
+
+import (
+	"math/rand"
+	"testing"
+)
+
+func TestGen(t *testing.T) {
+	// Test GenOption and GenResult with a custom value generator
+	r := rand.New(rand.NewSource(1))
+	var some, none, ok, errs int
+	for range 50 {
+		o := GenOption(r, func(r *rand.Rand) int { return r.Intn(10) })
+		if o.IsSome() {
+			some++
+		} else {
+			none++
+		}
+		if o.IsSome() && o.Unwrap() >= 10 {
+			t.Errorf("Expected values from the generator, got %v", o)
+		}
+		res := GenResult(r, func(r *rand.Rand) int { return 7 })
+		if res.IsOk() {
+			ok++
+		} else {
+			errs++
+		}
+		if res.IsOk() && res.Unwrap() != 7 {
+			t.Errorf("Expected Ok(7), got %v", res)
+		}
+	}
+	if some == 0 || none == 0 || ok == 0 || errs == 0 {
+		t.Errorf("Expected every variant to be generated, got %d/%d Some/None and %d/%d Ok/Err", some, none, ok, errs)
+	}
+}
@@ -1,3 +1,5 @@
 module github.com/dendianugerah/jagain
 
-go 1.22
+go 1.23
+
+require github.com/BurntSushi/toml v1.6.0
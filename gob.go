@@ -0,0 +1,94 @@
+package jagain
+
+import (
+	"bytes"
+	"encoding/gob"
+	"errors"
+)
+
+// GobEncode implements the gob.GobEncoder interface.
+func (o Option[T]) GobEncode() ([]byte, error) {
+	var buf bytes.Buffer
+	enc := gob.NewEncoder(&buf)
+	if err := enc.Encode(o.valid); err != nil {
+		return nil, err
+	}
+	if o.valid {
+		if err := enc.Encode(o.value); err != nil {
+			return nil, err
+		}
+	}
+	return buf.Bytes(), nil
+}
+
+// GobDecode implements the gob.GobDecoder interface.
+func (o *Option[T]) GobDecode(data []byte) error {
+	dec := gob.NewDecoder(bytes.NewReader(data))
+	var valid bool
+	if err := dec.Decode(&valid); err != nil {
+		return err
+	}
+	if !valid {
+		*o = None[T]()
+		return nil
+	}
+
+	var value T
+	if err := dec.Decode(&value); err != nil {
+		return err
+	}
+
+	*o = Some(value)
+	return nil
+}
+
+// GobEncode implements the gob.GobEncoder interface.
+// Errors are encoded by their message only, since arbitrary error types cannot be transmitted.
+func (r Result[T]) GobEncode() ([]byte, error) {
+	var buf bytes.Buffer
+	enc := gob.NewEncoder(&buf)
+	if err := enc.Encode(r.valid); err != nil {
+		return nil, err
+	}
+	if r.valid {
+		if err := enc.Encode(r.value); err != nil {
+			return nil, err
+		}
+	} else {
+		var msg string
+		if r.err != nil {
+			msg = r.err.Error()
+		}
+		if err := enc.Encode(msg); err != nil {
+			return nil, err
+		}
+	}
+	return buf.Bytes(), nil
+}
+
+// GobDecode implements the gob.GobDecoder interface.
+// A decoded error carries the original message but not the original error type.
+func (r *Result[T]) GobDecode(data []byte) error {
+	dec := gob.NewDecoder(bytes.NewReader(data))
+	var valid bool
+	if err := dec.Decode(&valid); err != nil {
+		return err
+	}
+	if !valid {
+		var msg string
+		if err := dec.Decode(&msg); err != nil {
+			return err
+		}
+		// The failure was reported where it happened, so decoding does not run the hooks.
+		*r = errResult[T](errors.New(msg))
+		return nil
+	}
+
+	var value T
+	if err := dec.Decode(&value); err != nil {
+		return err
+	}
+
+	*r = Ok(value)
+	return nil
+}
@@ -0,0 +1,58 @@
+package jagain
+
+import (
+	"bytes"
+	"encoding/gob"
+	"errors"
+	"runtime"
+	"testing"
+)
+
+type gobRecord struct {
+	Name   string
+	Email  Option[string]
+	Age    Option[int]
+	Lookup Result[int]
+	Failed Result[int]
+}
+
+func TestGob(t *testing.T) {
+	record := gobRecord{
+		Name:   "Jane",
+		Email:  Some("jane@example.com"),
+		Age:    None[int](),
+		Lookup: Ok(7),
+		Failed: Err[int](errors.New("lookup failed")),
+	}
+
+	var buf bytes.Buffer
+	if err := gob.NewEncoder(&buf).Encode(record); err != nil {
+		t.Fatalf("Failed to gob encode: %v", err)
+	}
+
+	// Test that decoding a failure does not report it again
+	created := 0
+	remove := OnErrCreated(func(error, runtime.Frame) { created++ })
+	var decoded gobRecord
+	err := gob.NewDecoder(&buf).Decode(&decoded)
+	remove()
+	if err != nil {
+		t.Fatalf("Failed to gob decode: %v", err)
+	}
+	if created != 0 {
+		t.Errorf("Expected decoding to run no OnErrCreated hooks, got %d calls", created)
+	}
+
+	if decoded.Email.UnwrapOr("") != "jane@example.com" {
+		t.Errorf("Expected Email to survive gob, got %v", decoded.Email)
+	}
+	if !decoded.Age.IsNone() {
+		t.Errorf("Expected Age to be None, got %v", decoded.Age)
+	}
+	if !decoded.Lookup.IsOk() || decoded.Lookup.Unwrap() != 7 {
+		t.Errorf("Expected Lookup to be Ok(7), got %v", decoded.Lookup)
+	}
+	if !decoded.Failed.IsErr() || decoded.Failed.UnwrapErr().Error() != "lookup failed" {
+		t.Errorf("Expected Failed to be Err(lookup failed), got %v", decoded.Failed)
+	}
+}
@@ -0,0 +1,36 @@
+package jagain
+
+import (
+	"reflect"
+	"time"
+)
+
+// GormDataType implements GORM's GormDataTypeInterface.
+// It reports the data type of T, so GORM migrates and compares an Option column the same
+// way it would a plain T column. Types GORM has no general type for are reported as string.
+func (o Option[T]) GormDataType() string {
+	var value T
+	if typer, ok := any(value).(interface{ GormDataType() string }); ok {
+		return typer.GormDataType()
+	}
+	if _, ok := any(value).(time.Time); ok {
+		return "time"
+	}
+
+	t := reflect.TypeFor[T]()
+	switch t.Kind() {
+	case reflect.Bool:
+		return "bool"
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return "int"
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return "uint"
+	case reflect.Float32, reflect.Float64:
+		return "float"
+	case reflect.Slice:
+		if t.Elem().Kind() == reflect.Uint8 {
+			return "bytes"
+		}
+	}
+	return "string"
+}
@@ -0,0 +1,26 @@
+package jagain
+
+import (
+	"testing"
+	"time"
+)
+
+func TestOptionGormDataType(t *testing.T) {
+	cases := []struct {
+		got, want string
+	}{
+		{Option[bool]{}.GormDataType(), "bool"},
+		{Option[int32]{}.GormDataType(), "int"},
+		{Option[uint]{}.GormDataType(), "uint"},
+		{Option[float64]{}.GormDataType(), "float"},
+		{Option[string]{}.GormDataType(), "string"},
+		{Option[time.Time]{}.GormDataType(), "time"},
+		{Option[[]byte]{}.GormDataType(), "bytes"},
+		{Option[map[string]int]{}.GormDataType(), "string"},
+	}
+	for _, c := range cases {
+		if c.got != c.want {
+			t.Errorf("Expected GormDataType to be %q, got %q", c.want, c.got)
+		}
+	}
+}
@@ -0,0 +1,58 @@
+package jagain
+
+import (
+	"encoding/json"
+	"io"
+)
+
+// gqlMarshaler matches gqlgen's graphql.Marshaler interface.
+type gqlMarshaler interface {
+	MarshalGQL(w io.Writer)
+}
+
+// gqlUnmarshaler matches gqlgen's graphql.Unmarshaler interface.
+type gqlUnmarshaler interface {
+	UnmarshalGQL(v any) error
+}
+
+// MarshalGQL implements gqlgen's graphql.Marshaler interface, so Option can be bound to a
+// nullable GraphQL field. None is written as null; Some uses T's own MarshalGQL when it has
+// one and JSON otherwise.
+func (o Option[T]) MarshalGQL(w io.Writer) {
+	if !o.valid {
+		io.WriteString(w, "null")
+		return
+	}
+	if m, ok := any(o.value).(gqlMarshaler); ok {
+		m.MarshalGQL(w)
+		return
+	}
+
+	data, err := json.Marshal(o.value)
+	if err != nil {
+		io.WriteString(w, "null")
+		return
+	}
+	w.Write(data)
+}
+
+// UnmarshalGQL implements gqlgen's graphql.Unmarshaler interface.
+// A null input decodes to None.
+func (o *Option[T]) UnmarshalGQL(v any) error {
+	if v == nil {
+		*o = None[T]()
+		return nil
+	}
+
+	var value T
+	if u, ok := any(&value).(gqlUnmarshaler); ok {
+		if err := u.UnmarshalGQL(v); err != nil {
+			return err
+		}
+	} else if err := assignValue(v, &value); err != nil {
+		return err
+	}
+
+	*o = Some(value)
+	return nil
+}
@@ -0,0 +1,69 @@
+package jagain
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"strings"
+	"testing"
+)
+
+// gqlID is a custom scalar with its own gqlgen marshaling.
+type gqlID string
+
+func (id gqlID) MarshalGQL(w io.Writer) {
+	fmt.Fprintf(w, `"id:%s"`, string(id))
+}
+
+func (id *gqlID) UnmarshalGQL(v any) error {
+	s, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("id must be a string")
+	}
+	*id = gqlID(strings.TrimPrefix(s, "id:"))
+	return nil
+}
+
+func TestOptionGQL(t *testing.T) {
+	// Test MarshalGQL
+	var sb strings.Builder
+	Some("hi").MarshalGQL(&sb)
+	if sb.String() != `"hi"` {
+		t.Errorf("Expected Some(\"hi\") to marshal as '\"hi\"', got '%s'", sb.String())
+	}
+	sb.Reset()
+	None[int]().MarshalGQL(&sb)
+	if sb.String() != "null" {
+		t.Errorf("Expected None to marshal as 'null', got '%s'", sb.String())
+	}
+	sb.Reset()
+	Some(gqlID("7")).MarshalGQL(&sb)
+	if sb.String() != `"id:7"` {
+		t.Errorf("Expected inner MarshalGQL to be used, got '%s'", sb.String())
+	}
+
+	// Test UnmarshalGQL
+	var i Option[int]
+	if err := i.UnmarshalGQL(json.Number("12")); err != nil || i.UnwrapOr(0) != 12 {
+		t.Errorf("Expected json.Number to unmarshal as Some(12), got %v (%v)", i, err)
+	}
+	if err := i.UnmarshalGQL(int64(3)); err != nil || i.UnwrapOr(0) != 3 {
+		t.Errorf("Expected int64 to unmarshal as Some(3), got %v (%v)", i, err)
+	}
+	if err := i.UnmarshalGQL(nil); err != nil || !i.IsNone() {
+		t.Errorf("Expected null to unmarshal as None, got %v (%v)", i, err)
+	}
+	if err := i.UnmarshalGQL("x"); err == nil {
+		t.Errorf("Expected string input to fail for Option[int]")
+	}
+
+	var id Option[gqlID]
+	if err := id.UnmarshalGQL("id:9"); err != nil || id.UnwrapOr("") != "9" {
+		t.Errorf("Expected inner UnmarshalGQL to be used, got %v (%v)", id, err)
+	}
+
+	var input Option[map[string]any]
+	if err := input.UnmarshalGQL(map[string]any{"a": 1}); err != nil || input.Unwrap()["a"] != 1 {
+		t.Errorf("Expected input object to unmarshal as Some, got %v (%v)", input, err)
+	}
+}
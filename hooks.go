@@ -0,0 +1,81 @@
+package jagain
+
+import (
+	"errors"
+	"runtime"
+	"slices"
+	"sync"
+	"sync/atomic"
+)
+
+// Hook receives an error together with the frame of the code that triggered the event.
+type Hook func(err error, caller runtime.Frame)
+
+type hookEntry struct {
+	fn Hook
+}
+
+// hookList is a copy-on-write list of hooks, so that firing them needs no lock.
+type hookList struct {
+	mu      sync.Mutex
+	entries atomic.Pointer[[]*hookEntry]
+}
+
+var (
+	errCreatedHooks  hookList
+	unwrapPanicHooks hookList
+)
+
+// errUnwrapOk is the error passed to OnUnwrapPanic hooks when UnwrapErr is called on an Ok result.
+var errUnwrapOk = errors.New("called unwrap_err on an ok result")
+
+// OnErrCreated registers a hook that runs once for every failure, when its Err result is
+// created, receiving the error and the first caller outside this package. Combinators that
+// pass on an existing error, such as MapTo, MapErr, Wrap and WithField, do not run it again.
+// Hooks run synchronously, so they should be fast.
+// The returned function removes the hook.
+func OnErrCreated(h Hook) (remove func()) {
+	return errCreatedHooks.add(h)
+}
+
+// OnUnwrapPanic registers a hook that runs just before Unwrap or UnwrapErr panics,
+// receiving the error behind the panic and the caller of the unwrap method: ErrNoValue for
+// Option.Unwrap, the contained error for Result.Unwrap. The returned function removes the hook.
+func OnUnwrapPanic(h Hook) (remove func()) {
+	return unwrapPanicHooks.add(h)
+}
+
+func (l *hookList) add(h Hook) func() {
+	entry := &hookEntry{fn: h}
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	var entries []*hookEntry
+	if p := l.entries.Load(); p != nil {
+		entries = slices.Clone(*p)
+	}
+	entries = append(entries, entry)
+	l.entries.Store(&entries)
+
+	return func() {
+		l.mu.Lock()
+		defer l.mu.Unlock()
+		entries := slices.DeleteFunc(slices.Clone(*l.entries.Load()), func(e *hookEntry) bool {
+			return e == entry
+		})
+		l.entries.Store(&entries)
+	}
+}
+
+// fire runs the hooks with the first frame outside the package at least skip levels above
+// its caller. It does nothing, not even a stack walk, when no hooks are registered.
+func (l *hookList) fire(err error, skip int) {
+	p := l.entries.Load()
+	if p == nil || len(*p) == 0 {
+		return
+	}
+
+	caller, _ := callerOutsidePackage(skip + 1)
+	for _, entry := range *p {
+		entry.fn(err, caller)
+	}
+}
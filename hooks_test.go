@@ -0,0 +1,75 @@
+package jagain
+
+import (
+	"errors"
+	"runtime"
+	"strings"
+	"testing"
+)
+
+func TestHooks(t *testing.T) {
+	var created []error
+	var createdAt runtime.Frame
+	remove := OnErrCreated(func(err error, caller runtime.Frame) {
+		created = append(created, err)
+		createdAt = caller
+	})
+
+	// Test OnErrCreated
+	errBoom := errors.New("boom")
+	Err[int](errBoom)
+	if len(created) != 1 || created[0] != errBoom {
+		t.Errorf("Expected hook to receive the error, got %v", created)
+	}
+	if !strings.HasSuffix(createdAt.Function, "TestHooks") || !strings.HasSuffix(createdAt.File, "hooks_test.go") {
+		t.Errorf("Expected caller to be the test, got %s (%s)", createdAt.Function, createdAt.File)
+	}
+
+	// Test combinators do not run the hook again for the same failure
+	created = nil
+	r := MapTo(Err[int](errBoom), func(v int) string { return "" }).
+		Wrap("load").
+		Wrapf("user %d", 1).
+		WithField("id", 1).
+		MapErr(func(err error) error { return err })
+	r = FlatMapTo(r, func(string) Result[string] { return Ok("") })
+	AppendErr(r, errors.New("cleanup"))
+	if len(created) != 1 {
+		t.Errorf("Expected one hook run per failure, got %d: %v", len(created), created)
+	}
+
+	// Test failures created inside the package report the caller outside it
+	created = nil
+	None[int]().ToResult(errBoom)
+	if len(created) != 1 || !strings.HasSuffix(createdAt.File, "hooks_test.go") {
+		t.Errorf("Expected one hook run from the test, got %v at %s", created, createdAt.File)
+	}
+
+	// Test removal
+	remove()
+	created = nil
+	Err[int](errBoom)
+	if len(created) != 0 {
+		t.Errorf("Expected removed hook not to run")
+	}
+
+	// Test OnUnwrapPanic
+	var panicked []error
+	defer OnUnwrapPanic(func(err error, caller runtime.Frame) {
+		panicked = append(panicked, err)
+	})()
+	unwrap := func(f func()) {
+		defer func() { recover() }()
+		f()
+	}
+	unwrap(func() { None[int]().Unwrap() })
+	unwrap(func() { Err[int](errBoom).Unwrap() })
+	unwrap(func() { Ok(1).UnwrapErr() })
+	if len(panicked) != 3 || panicked[0] != ErrNoValue || panicked[1] != errBoom {
+		t.Errorf("Expected hook to see each unwrap panic, got %v", panicked)
+	}
+	Some(1).Unwrap()
+	if len(panicked) != 3 {
+		t.Errorf("Expected successful unwraps not to run the hook")
+	}
+}
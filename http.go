@@ -0,0 +1,105 @@
+package jagain
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+)
+
+// ErrorEncoder writes an error response for a failed handler.
+type ErrorEncoder func(w http.ResponseWriter, r *http.Request, err error)
+
+// DefaultErrorEncoder is the ErrorEncoder used by Handler.
+// It may be replaced at startup to change how every Handler renders errors.
+var DefaultErrorEncoder ErrorEncoder = EncodeJSONError
+
+// Handler adapts f to an http.Handler. An Ok value is written as JSON with status 200,
+// and an Err is rendered by DefaultErrorEncoder.
+func Handler[T any](f func(*http.Request) Result[T]) http.Handler {
+	return HandlerWith(f, nil)
+}
+
+// HandlerWith is like Handler but renders errors with enc.
+// Every call is reported to the installed Metrics, labeled with the matched route.
+// A nil enc uses DefaultErrorEncoder at the time of each request.
+func HandlerWith[T any](f func(*http.Request) Result[T], enc ErrorEncoder) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		encode := enc
+		if encode == nil {
+			encode = DefaultErrorEncoder
+		}
+
+		result := Observe(httpLabel(r), func() Result[T] { return f(r) })
+		render(w, r, result, encode)
+	})
+}
+
+// Render writes result as Handler would: an Ok value as JSON with status 200, and an Err
+// through DefaultErrorEncoder. It lets handlers of other routers share Handler's responses.
+func Render[T any](w http.ResponseWriter, r *http.Request, result Result[T]) {
+	render(w, r, result, DefaultErrorEncoder)
+}
+
+func render[T any](w http.ResponseWriter, r *http.Request, result Result[T], encode ErrorEncoder) {
+	if !result.valid {
+		encode(w, r, result.err)
+		return
+	}
+
+	body, err := json.Marshal(result.value)
+	if err != nil {
+		encode(w, r, err)
+		return
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	w.Write(append(body, '\n'))
+}
+
+// EncodeJSONError writes err as a JSON object of the form {"error": "message"}, adding a
+// "code" member when the error carries a code from WithCode.
+// The status code is taken from a StatusCode() int method found in the error chain;
+// otherwise FieldError maps to 400, ErrNoValue to 404, http.MaxBytesError to 413,
+// context.DeadlineExceeded to 504 and anything else to 500.
+// The message is the error text only for 4xx statuses, FieldErrors and errors with a code;
+// other errors, which may describe server internals, get the status text instead.
+// A PanicError always gets the status text, so Recover never reveals the panic value.
+func EncodeJSONError(w http.ResponseWriter, r *http.Request, err error) {
+	status := HTTPStatus(err)
+	code := ErrorCode(err)
+	msg := http.StatusText(status)
+	var fieldErr *FieldError
+	var panicErr *PanicError
+	if err != nil && !errors.As(err, &panicErr) && (status < 500 || errors.As(err, &fieldErr) || code.IsSome()) {
+		msg = err.Error()
+	}
+	doc := map[string]string{"error": msg}
+	if code.IsSome() {
+		doc["code"] = code.Unwrap()
+	}
+	body, _ := json.Marshal(doc)
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	w.Write(append(body, '\n'))
+}
+
+// HTTPStatus returns the HTTP status code EncodeJSONError uses for err.
+func HTTPStatus(err error) int {
+	var coder interface{ StatusCode() int }
+	var fieldErr *FieldError
+	var tooLarge *http.MaxBytesError
+	switch {
+	case errors.As(err, &coder):
+		return coder.StatusCode()
+	case errors.As(err, &fieldErr):
+		return http.StatusBadRequest
+	case errors.Is(err, ErrNoValue):
+		return http.StatusNotFound
+	case errors.As(err, &tooLarge):
+		return http.StatusRequestEntityTooLarge
+	case errors.Is(err, context.DeadlineExceeded):
+		return http.StatusGatewayTimeout
+	}
+	return http.StatusInternalServerError
+}
@@ -0,0 +1,89 @@
+package jagain
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+type teapotError struct{}
+
+func (teapotError) Error() string   { return "short and stout" }
+func (teapotError) StatusCode() int { return http.StatusTeapot }
+
+func TestHandler(t *testing.T) {
+	h := Handler(func(r *http.Request) Result[map[string]int] {
+		switch r.URL.Query().Get("case") {
+		case "missing":
+			return Err[map[string]int](ErrNoValue)
+		case "teapot":
+			return Err[map[string]int](teapotError{})
+		case "invalid":
+			return Err[map[string]int](&FieldError{Path: "id", Err: errors.New("field is required")})
+		case "failed":
+			return Err[map[string]int](errors.New("database down"))
+		case "coded":
+			return Err[map[string]int](WithCode("DB_DOWN", errors.New("database down")))
+		}
+		return Ok(map[string]int{"id": 7})
+	})
+
+	tests := []struct {
+		query  string
+		status int
+		body   string
+	}{
+		{"", http.StatusOK, `{"id":7}`},
+		{"case=missing", http.StatusNotFound, `{"error":"option contains no value"}`},
+		{"case=teapot", http.StatusTeapot, `{"error":"short and stout"}`},
+		{"case=invalid", http.StatusBadRequest, `{"error":"id: field is required"}`},
+		{"case=failed", http.StatusInternalServerError, `{"error":"Internal Server Error"}`},
+		{"case=coded", http.StatusInternalServerError, `{"code":"DB_DOWN","error":"database down"}`},
+	}
+	for _, tt := range tests {
+		rec := httptest.NewRecorder()
+		h.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/?"+tt.query, nil))
+		if rec.Code != tt.status || strings.TrimSpace(rec.Body.String()) != tt.body {
+			t.Errorf("Expected %d %s for %q, got %d %s", tt.status, tt.body, tt.query, rec.Code, rec.Body.String())
+		}
+		if rec.Header().Get("Content-Type") != "application/json" {
+			t.Errorf("Expected JSON content type for %q", tt.query)
+		}
+	}
+
+	// Test a custom error encoder
+	custom := HandlerWith(func(*http.Request) Result[int] {
+		return Err[int](errors.New("nope"))
+	}, func(w http.ResponseWriter, r *http.Request, err error) {
+		http.Error(w, err.Error(), http.StatusConflict)
+	})
+	rec := httptest.NewRecorder()
+	custom.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/", nil))
+	if rec.Code != http.StatusConflict || strings.TrimSpace(rec.Body.String()) != "nope" {
+		t.Errorf("Expected custom encoder to be used, got %d %s", rec.Code, rec.Body.String())
+	}
+}
+
+func TestRender(t *testing.T) {
+	rec := httptest.NewRecorder()
+	Render(rec, httptest.NewRequest(http.MethodGet, "/", nil), Ok([]int{1, 2}))
+	if rec.Code != http.StatusOK || strings.TrimSpace(rec.Body.String()) != "[1,2]" {
+		t.Errorf("Expected 200 [1,2], got %d %s", rec.Code, rec.Body.String())
+	}
+
+	rec = httptest.NewRecorder()
+	Render(rec, httptest.NewRequest(http.MethodGet, "/", nil), Err[int](teapotError{}))
+	if rec.Code != http.StatusTeapot {
+		t.Errorf("Expected 418, got %d", rec.Code)
+	}
+}
+
+// encodeErrorBody renders err with EncodeJSONError and returns the trimmed body.
+func encodeErrorBody(t *testing.T, err error) string {
+	t.Helper()
+	rec := httptest.NewRecorder()
+	EncodeJSONError(rec, httptest.NewRequest(http.MethodGet, "/", nil), err)
+	return strings.TrimSpace(rec.Body.String())
+}
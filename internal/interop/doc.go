@@ -0,0 +1,4 @@
+// Package interop tests jagain against third-party libraries that use Options only
+// through standard interfaces, such as encoding.BinaryMarshaler. It is a module of its
+// own so that the root module does not depend on those libraries.
+package interop
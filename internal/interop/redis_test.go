@@ -0,0 +1,63 @@
+package interop
+
+import (
+	"context"
+	"testing"
+
+	"github.com/dendianugerah/jagain"
+	"github.com/redis/go-redis/v9"
+)
+
+type redisSession struct {
+	User  string                `redis:"user"`
+	Email jagain.Option[string] `redis:"email"`
+	Visit jagain.Option[int]    `redis:"visits"`
+}
+
+// redisValue returns what go-redis stores for v, which it writes with MarshalBinary.
+func redisValue(t *testing.T, v interface{ MarshalBinary() ([]byte, error) }) string {
+	t.Helper()
+	data, err := v.MarshalBinary()
+	if err != nil {
+		t.Fatalf("Failed to marshal %v: %v", v, err)
+	}
+	return string(data)
+}
+
+func TestRedis(t *testing.T) {
+	// Test go-redis scanning a single value
+	var visits jagain.Option[int]
+	cmd := redis.NewStringCmd(context.Background())
+	cmd.SetVal(redisValue(t, jagain.Some(7)))
+	if err := cmd.Scan(&visits); err != nil || visits.UnwrapOr(0) != 7 {
+		t.Errorf("Expected redis value to scan as Some(7), got %v (%v)", visits, err)
+	}
+
+	// Test that an empty string survives as Some
+	var email jagain.Option[string]
+	cmd.SetVal(redisValue(t, jagain.Some("")))
+	if err := cmd.Scan(&email); err != nil || !email.IsSome() {
+		t.Errorf("Expected Some(\"\"), got %v (%v)", email, err)
+	}
+
+	// Test that values written by other clients are rejected
+	cmd.SetVal("7")
+	if err := cmd.Scan(&visits); err == nil {
+		t.Errorf("Expected an untagged value to fail, got %v", visits)
+	}
+
+	// Test go-redis scanning a hash into a struct
+	var session redisSession
+	hash := redis.NewMapStringStringCmd(context.Background())
+	hash.SetVal(map[string]string{
+		"user":   "jane",
+		"email":  redisValue(t, jagain.None[string]()),
+		"visits": redisValue(t, jagain.Some(3)),
+	})
+	if err := hash.Scan(&session); err != nil {
+		t.Fatalf("Failed to scan hash: %v", err)
+	}
+	if session.Visit.UnwrapOr(0) != 3 || !session.Email.IsNone() {
+		t.Errorf("Expected visits Some(3) and email None, got %+v", session)
+	}
+}
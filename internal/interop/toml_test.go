@@ -0,0 +1,38 @@
+package interop
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/dendianugerah/jagain"
+	"github.com/pelletier/go-toml/v2"
+)
+
+func TestPelletierTOML(t *testing.T) {
+	// Test encoding
+	var sb strings.Builder
+	plain := struct {
+		Port  jagain.Option[int]    `toml:"port"`
+		Debug jagain.Option[bool]   `toml:"debug"`
+		Name  jagain.Option[string] `toml:"name"`
+	}{Port: jagain.Some(9090), Name: jagain.Some("svc")}
+	if err := toml.NewEncoder(&sb).EnableMarshalerInterface().Encode(plain); err != nil {
+		t.Fatalf("Failed to encode TOML: %v", err)
+	}
+	out := sb.String()
+	if !strings.Contains(out, "port = 9090") || strings.Contains(out, "debug") {
+		t.Errorf("Expected port to be written and debug omitted, got:\n%s", out)
+	}
+
+	// Test decoding string values
+	var decoded struct {
+		Name jagain.Option[string] `toml:"name"`
+		Note jagain.Option[string] `toml:"note"`
+	}
+	if err := toml.Unmarshal([]byte(`name = "svc"`), &decoded); err != nil {
+		t.Fatalf("Failed to decode TOML: %v", err)
+	}
+	if decoded.Name.UnwrapOr("") != "svc" || !decoded.Note.IsNone() {
+		t.Errorf("Expected name to be Some and note None, got %v and %v", decoded.Name, decoded.Note)
+	}
+}
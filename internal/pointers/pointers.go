@@ -0,0 +1,196 @@
+// Package pointers converts values holding jagain Options to and from values in which
+// each Option[T] is replaced by *T, for encoders that understand pointers but not Options.
+// It only uses the exported API of Option, so the adapters built on it can live outside
+// the root module.
+package pointers
+
+import (
+	"database/sql"
+	"encoding"
+	"reflect"
+	"strings"
+	"sync"
+)
+
+var pointerTypes sync.Map // map[reflect.Type]reflect.Type
+
+// Type returns the type encoders that understand pointers but not Options can handle in
+// place of t: t itself if it holds no Options, and otherwise a copy of its structure with
+// each Option[T] replaced by *T. Unexported struct fields are dropped from the copy, and
+// Options inside recursive types are not replaced.
+func Type(t reflect.Type) reflect.Type {
+	return pointerTypeOf(t, map[reflect.Type]bool{})
+}
+
+// optionElem reports whether t is a jagain.Option and returns its element type.
+func optionElem(t reflect.Type) (reflect.Type, bool) {
+	if t.PkgPath() != "github.com/dendianugerah/jagain" || !strings.HasPrefix(t.Name(), "Option[") {
+		return nil, false
+	}
+	toPtr, ok := t.MethodByName("ToPtr")
+	if !ok {
+		return nil, false
+	}
+	return toPtr.Type.Out(0).Elem(), true
+}
+
+func pointerTypeOf(t reflect.Type, visiting map[reflect.Type]bool) reflect.Type {
+	if cached, ok := pointerTypes.Load(t); ok {
+		return cached.(reflect.Type)
+	}
+	if visiting[t] {
+		return t
+	}
+	visiting[t] = true
+	at := buildPointerType(t, visiting)
+	delete(visiting, t)
+	pointerTypes.Store(t, at)
+	return at
+}
+
+func buildPointerType(t reflect.Type, visiting map[reflect.Type]bool) reflect.Type {
+	if elem, ok := optionElem(t); ok {
+		return reflect.PointerTo(pointerTypeOf(elem, visiting))
+	}
+	switch t.Kind() {
+	case reflect.Pointer:
+		if elem := pointerTypeOf(t.Elem(), visiting); elem != t.Elem() {
+			return reflect.PointerTo(elem)
+		}
+	case reflect.Slice:
+		if elem := pointerTypeOf(t.Elem(), visiting); elem != t.Elem() {
+			return reflect.SliceOf(elem)
+		}
+	case reflect.Map:
+		if elem := pointerTypeOf(t.Elem(), visiting); elem != t.Elem() {
+			return reflect.MapOf(t.Key(), elem)
+		}
+	case reflect.Struct:
+		if reflect.PointerTo(t).Implements(reflect.TypeFor[encoding.TextUnmarshaler]()) {
+			return t
+		}
+		changed := false
+		var fields []reflect.StructField
+		for i := range t.NumField() {
+			f := t.Field(i)
+			if !f.IsExported() {
+				continue
+			}
+			if ft := pointerTypeOf(f.Type, visiting); ft != f.Type {
+				f.Type = ft
+				f.Anonymous = false
+				changed = true
+			}
+			f.Index = nil
+			f.Offset = 0
+			fields = append(fields, f)
+		}
+		if changed {
+			return reflect.StructOf(fields)
+		}
+	}
+	return t
+}
+
+// To converts src to t, the Type of its type.
+func To(src reflect.Value, t reflect.Type) reflect.Value {
+	if src.Type() == t {
+		return src
+	}
+	dst := reflect.New(t).Elem()
+	if _, ok := optionElem(src.Type()); ok {
+		if v := src.MethodByName("ToPtr").Call(nil)[0]; !v.IsNil() {
+			p := reflect.New(t.Elem())
+			p.Elem().Set(To(v.Elem(), t.Elem()))
+			dst.Set(p)
+		}
+		return dst
+	}
+	switch src.Kind() {
+	case reflect.Pointer:
+		if !src.IsNil() {
+			p := reflect.New(t.Elem())
+			p.Elem().Set(To(src.Elem(), t.Elem()))
+			dst.Set(p)
+		}
+	case reflect.Slice:
+		if !src.IsNil() {
+			dst.Set(reflect.MakeSlice(t, src.Len(), src.Len()))
+			for i := range src.Len() {
+				dst.Index(i).Set(To(src.Index(i), t.Elem()))
+			}
+		}
+	case reflect.Map:
+		if !src.IsNil() {
+			dst.Set(reflect.MakeMapWithSize(t, src.Len()))
+			for iter := src.MapRange(); iter.Next(); {
+				dst.SetMapIndex(iter.Key(), To(iter.Value(), t.Elem()))
+			}
+		}
+	case reflect.Struct:
+		for i := range t.NumField() {
+			f := t.Field(i)
+			dst.Field(i).Set(To(src.FieldByName(f.Name), f.Type))
+		}
+	}
+	return dst
+}
+
+// From stores src, a value of the Type of dst's type, into dst. Values are stored into
+// Options with Option.Scan, which assigns a value of the element type as-is unless the
+// element type has its own sql.Scanner.
+func From(src, dst reflect.Value) error {
+	if src.Type() == dst.Type() {
+		dst.Set(src)
+		return nil
+	}
+
+	if elem, ok := optionElem(dst.Type()); ok {
+		dst.SetZero()
+		if src.IsNil() {
+			return nil
+		}
+		v := reflect.New(elem).Elem()
+		if err := From(src.Elem(), v); err != nil {
+			return err
+		}
+		return dst.Addr().Interface().(sql.Scanner).Scan(v.Interface())
+	}
+	switch dst.Kind() {
+	case reflect.Pointer:
+		dst.SetZero()
+		if !src.IsNil() {
+			dst.Set(reflect.New(dst.Type().Elem()))
+			return From(src.Elem(), dst.Elem())
+		}
+	case reflect.Slice:
+		dst.SetZero()
+		if !src.IsNil() {
+			dst.Set(reflect.MakeSlice(dst.Type(), src.Len(), src.Len()))
+			for i := range src.Len() {
+				if err := From(src.Index(i), dst.Index(i)); err != nil {
+					return err
+				}
+			}
+		}
+	case reflect.Map:
+		dst.SetZero()
+		if !src.IsNil() {
+			dst.Set(reflect.MakeMapWithSize(dst.Type(), src.Len()))
+			for iter := src.MapRange(); iter.Next(); {
+				v := reflect.New(dst.Type().Elem()).Elem()
+				if err := From(iter.Value(), v); err != nil {
+					return err
+				}
+				dst.SetMapIndex(iter.Key(), v)
+			}
+		}
+	case reflect.Struct:
+		for i := range src.NumField() {
+			if err := From(src.Field(i), dst.FieldByName(src.Type().Field(i).Name)); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
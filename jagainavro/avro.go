@@ -0,0 +1,45 @@
+// Package jagainavro encodes jagain Options with github.com/hamba/avro, which cannot see
+// inside Options on its own. Option[T] fields, including those of nested records, arrays
+// and maps, are written as the union ["null", T]: None as null and Some as T.
+package jagainavro
+
+import (
+	"fmt"
+	"reflect"
+
+	"github.com/dendianugerah/jagain/internal/pointers"
+	"github.com/hamba/avro/v2"
+)
+
+// Marshal encodes v with the Avro schema. Struct fields are matched to record fields by
+// their avro tags, as in avro.Marshal.
+func Marshal(schema avro.Schema, v any) ([]byte, error) {
+	rv := reflect.ValueOf(v)
+	if !rv.IsValid() {
+		return avro.Marshal(schema, v)
+	}
+	t := pointers.Type(rv.Type())
+	if t == rv.Type() {
+		return avro.Marshal(schema, v)
+	}
+	return avro.Marshal(schema, pointers.To(rv, t).Interface())
+}
+
+// Unmarshal decodes Avro data written with schema into v, which must be a non-nil
+// pointer. Option[T] fields accept the union ["null", T], decoding null as None.
+func Unmarshal(schema avro.Schema, data []byte, v any) error {
+	rv := reflect.ValueOf(v)
+	if rv.Kind() != reflect.Pointer || rv.IsNil() {
+		return fmt.Errorf("cannot unmarshal Avro into %T: not a non-nil pointer", v)
+	}
+	dst := rv.Elem()
+	t := pointers.Type(dst.Type())
+	if t == dst.Type() {
+		return avro.Unmarshal(schema, data, v)
+	}
+	tmp := reflect.New(t)
+	if err := avro.Unmarshal(schema, data, tmp.Interface()); err != nil {
+		return err
+	}
+	return pointers.From(tmp.Elem(), dst)
+}
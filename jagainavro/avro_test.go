@@ -0,0 +1,118 @@
+package jagainavro
+
+import (
+	"testing"
+	"time"
+
+	"github.com/dendianugerah/jagain"
+	"github.com/hamba/avro/v2"
+)
+
+type avroAddress struct {
+	City string                `avro:"city"`
+	Zip  jagain.Option[string] `avro:"zip"`
+}
+
+type avroUser struct {
+	Name     string                        `avro:"name"`
+	Email    jagain.Option[string]         `avro:"email"`
+	Age      jagain.Option[int]            `avro:"age"`
+	Joined   jagain.Option[time.Time]      `avro:"joined"`
+	Address  avroAddress                   `avro:"address"`
+	Previous []avroAddress                 `avro:"previous"`
+	Scores   map[string]jagain.Option[int] `avro:"scores"`
+	internal int
+}
+
+var avroUserSchema = avro.MustParse(`{
+	"type": "record", "name": "User",
+	"fields": [
+		{"name": "name", "type": "string"},
+		{"name": "email", "type": ["null", "string"]},
+		{"name": "age", "type": ["null", "int"]},
+		{"name": "joined", "type": ["null", {"type": "long", "logicalType": "timestamp-millis"}]},
+		{"name": "address", "type": {
+			"type": "record", "name": "Address",
+			"fields": [
+				{"name": "city", "type": "string"},
+				{"name": "zip", "type": ["null", "string"]}
+			]
+		}},
+		{"name": "previous", "type": {"type": "array", "items": "Address"}},
+		{"name": "scores", "type": {"type": "map", "values": ["null", "int"]}}
+	]
+}`)
+
+func TestAvro(t *testing.T) {
+	joined := time.Date(2024, 5, 1, 12, 0, 0, 0, time.UTC)
+	user := avroUser{
+		Name:     "Jane",
+		Email:    jagain.Some("jane@example.com"),
+		Age:      jagain.None[int](),
+		Joined:   jagain.Some(joined),
+		Address:  avroAddress{City: "Portland", Zip: jagain.Some("97201")},
+		Previous: []avroAddress{{City: "Austin", Zip: jagain.None[string]()}},
+		Scores:   map[string]jagain.Option[int]{"math": jagain.Some(90), "art": jagain.None[int]()},
+		internal: 1,
+	}
+
+	// Test round trip of a struct
+	data, err := Marshal(avroUserSchema, user)
+	if err != nil {
+		t.Fatalf("Failed to marshal user: %v", err)
+	}
+	var decoded avroUser
+	if err := Unmarshal(avroUserSchema, data, &decoded); err != nil {
+		t.Fatalf("Failed to unmarshal user: %v", err)
+	}
+	if decoded.Email.UnwrapOr("") != "jane@example.com" || decoded.Age.IsSome() {
+		t.Errorf("Expected Email Some and Age None, got %v and %v", decoded.Email, decoded.Age)
+	}
+	if !decoded.Joined.UnwrapOr(time.Time{}).Equal(joined) {
+		t.Errorf("Expected Joined to be jagain.Some(%v), got %v", joined, decoded.Joined)
+	}
+	if decoded.Address.Zip.UnwrapOr("") != "97201" || decoded.Previous[0].Zip.IsSome() {
+		t.Errorf("Expected nested Options to round trip, got %+v and %+v", decoded.Address, decoded.Previous)
+	}
+	if decoded.Scores["math"].UnwrapOr(0) != 90 || decoded.Scores["art"].IsSome() {
+		t.Errorf("Expected map Options to round trip, got %v", decoded.Scores)
+	}
+
+	// Test the encoding matches the equivalent pointer struct
+	type plainAddress struct {
+		City string  `avro:"city"`
+		Zip  *string `avro:"zip"`
+	}
+	type plainUser struct {
+		Name     string          `avro:"name"`
+		Email    *string         `avro:"email"`
+		Age      *int            `avro:"age"`
+		Joined   *time.Time      `avro:"joined"`
+		Address  plainAddress    `avro:"address"`
+		Previous []plainAddress  `avro:"previous"`
+		Scores   map[string]*int `avro:"scores"`
+	}
+	var plain plainUser
+	if err := avro.Unmarshal(avroUserSchema, data, &plain); err != nil {
+		t.Fatalf("Failed to unmarshal into pointers: %v", err)
+	}
+	if *plain.Email != "jane@example.com" || plain.Age != nil || *plain.Address.Zip != "97201" {
+		t.Errorf("Expected Options to be written as nullable unions, got %+v", plain)
+	}
+
+	// Test a top-level Option
+	schema := avro.MustParse(`["null", "string"]`)
+	data, err = Marshal(schema, jagain.None[string]())
+	if err != nil {
+		t.Fatalf("Failed to marshal None: %v", err)
+	}
+	opt := jagain.Some("stale")
+	if err := Unmarshal(schema, data, &opt); err != nil || opt.IsSome() {
+		t.Errorf("Expected None, got %v (%v)", opt, err)
+	}
+
+	// Test invalid targets
+	if err := Unmarshal(schema, data, opt); err == nil {
+		t.Errorf("Expected an error for a non-pointer target")
+	}
+}
@@ -0,0 +1,97 @@
+// Package jagainbson encodes jagain Options with the MongoDB Go driver's bson package.
+// None is encoded as BSON null; tag the field omitempty to leave it out of the document
+// instead. BSON null and undefined decode to None, as does a field missing from the document.
+package jagainbson
+
+import (
+	"database/sql"
+	"fmt"
+	"reflect"
+
+	"go.mongodb.org/mongo-driver/v2/bson"
+)
+
+// Register adds an encoder and decoder for every jagain.Option type to reg.
+//
+// Pass the registry to the client, for example:
+//
+//	reg := bson.NewRegistry()
+//	jagainbson.Register(reg)
+//	client, err := mongo.Connect(options.Client().ApplyURI(uri).SetRegistry(reg))
+func Register(reg *bson.Registry) {
+	reg.RegisterInterfaceEncoder(optionType, bson.ValueEncoderFunc(encodeOption))
+	reg.RegisterInterfaceDecoder(optionType, bson.ValueDecoderFunc(decodeOption))
+}
+
+// NewRegistry returns a registry with the default bson codecs and the ones added by Register.
+func NewRegistry() *bson.Registry {
+	reg := bson.NewRegistry()
+	Register(reg)
+	return reg
+}
+
+// optionType matches jagain.Option through methods that do not mention its type parameter.
+var optionType = reflect.TypeFor[interface {
+	IsSome() bool
+	IsNone() bool
+	IsZero() bool
+	Origin() string
+}]()
+
+// optionElem returns the element type of the Option type t.
+func optionElem(t reflect.Type) (reflect.Type, error) {
+	toPtr, ok := t.MethodByName("ToPtr")
+	if !ok || toPtr.Type.NumOut() != 1 || toPtr.Type.Out(0).Kind() != reflect.Pointer {
+		return nil, fmt.Errorf("jagainbson: %v is not a jagain.Option", t)
+	}
+	return toPtr.Type.Out(0).Elem(), nil
+}
+
+// encodeOption writes null for None and the contained value otherwise.
+func encodeOption(ec bson.EncodeContext, vw bson.ValueWriter, val reflect.Value) error {
+	if _, err := optionElem(val.Type()); err != nil {
+		return err
+	}
+	ptr := val.MethodByName("ToPtr").Call(nil)[0]
+	if ptr.IsNil() {
+		return vw.WriteNull()
+	}
+
+	enc, err := ec.LookupEncoder(ptr.Type().Elem())
+	if err != nil {
+		return err
+	}
+	return enc.EncodeValue(ec, vw, ptr.Elem())
+}
+
+// decodeOption decodes null and undefined as None and anything else through the decoder for
+// the element type. The decoded value is stored with Option.Scan, which assigns a value of
+// the element type as-is.
+func decodeOption(dc bson.DecodeContext, vr bson.ValueReader, val reflect.Value) error {
+	elem, err := optionElem(val.Type())
+	if err != nil {
+		return err
+	}
+	if !val.CanSet() {
+		return fmt.Errorf("jagainbson: cannot decode into unsettable %v", val.Type())
+	}
+
+	switch vr.Type() {
+	case bson.TypeNull:
+		val.Set(reflect.Zero(val.Type()))
+		return vr.ReadNull()
+	case bson.TypeUndefined:
+		val.Set(reflect.Zero(val.Type()))
+		return vr.ReadUndefined()
+	}
+
+	dec, err := dc.LookupDecoder(elem)
+	if err != nil {
+		return err
+	}
+	value := reflect.New(elem).Elem()
+	if err := dec.DecodeValue(dc, vr, value); err != nil {
+		return err
+	}
+	return val.Addr().Interface().(sql.Scanner).Scan(value.Interface())
+}
@@ -0,0 +1,86 @@
+package jagainbson
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/dendianugerah/jagain"
+	"go.mongodb.org/mongo-driver/v2/bson"
+)
+
+type bsonProfile struct {
+	Name     string                `bson:"name"`
+	Email    jagain.Option[string] `bson:"email"`
+	Age      jagain.Option[int32]  `bson:"age"`
+	Nickname jagain.Option[string] `bson:"nickname,omitempty"`
+}
+
+func marshal(t *testing.T, v any) []byte {
+	t.Helper()
+	var buf bytes.Buffer
+	enc := bson.NewEncoder(bson.NewDocumentWriter(&buf))
+	enc.SetRegistry(NewRegistry())
+	if err := enc.Encode(v); err != nil {
+		t.Fatalf("Failed to marshal %T: %v", v, err)
+	}
+	return buf.Bytes()
+}
+
+func unmarshal(t *testing.T, data []byte, v any) {
+	t.Helper()
+	dec := bson.NewDecoder(bson.NewDocumentReader(bytes.NewReader(data)))
+	dec.SetRegistry(NewRegistry())
+	if err := dec.Decode(v); err != nil {
+		t.Fatalf("Failed to unmarshal %T: %v", v, err)
+	}
+}
+
+func TestOptionBSON(t *testing.T) {
+	profile := bsonProfile{
+		Name:     "Jane",
+		Email:    jagain.Some("jane@example.com"),
+		Age:      jagain.None[int32](),
+		Nickname: jagain.None[string](),
+	}
+	data := marshal(t, profile)
+
+	// Test None is written as null unless omitempty is set
+	var doc bson.M
+	if err := bson.Unmarshal(data, &doc); err != nil {
+		t.Fatalf("Failed to unmarshal document: %v", err)
+	}
+	if age, ok := doc["age"]; !ok || age != nil {
+		t.Errorf("Expected age to be stored as null, got %v", doc["age"])
+	}
+	if _, ok := doc["nickname"]; ok {
+		t.Errorf("Expected omitempty None to be left out of the document")
+	}
+	if doc["email"] != "jane@example.com" {
+		t.Errorf("Expected email to be stored as a string, got %v", doc["email"])
+	}
+
+	// Test round trip
+	var decoded bsonProfile
+	unmarshal(t, data, &decoded)
+	if decoded.Email.UnwrapOr("") != "jane@example.com" {
+		t.Errorf("Expected Email to survive BSON, got %v", decoded.Email)
+	}
+	if !decoded.Age.IsNone() || !decoded.Nickname.IsNone() {
+		t.Errorf("Expected Age and Nickname to be None, got %v and %v", decoded.Age, decoded.Nickname)
+	}
+}
+
+func TestOptionBSONStruct(t *testing.T) {
+	type address struct {
+		City string `bson:"city"`
+	}
+	type user struct {
+		Address jagain.Option[address] `bson:"address"`
+	}
+
+	var decoded user
+	unmarshal(t, marshal(t, user{Address: jagain.Some(address{City: "Jakarta"})}), &decoded)
+	if decoded.Address.UnwrapOr(address{}).City != "Jakarta" {
+		t.Errorf("Expected Address to survive BSON, got %v", decoded.Address)
+	}
+}
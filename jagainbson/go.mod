@@ -0,0 +1,12 @@
+module github.com/dendianugerah/jagain/jagainbson
+
+go 1.23
+
+require (
+	github.com/dendianugerah/jagain v0.0.0-00010101000000-000000000000
+	go.mongodb.org/mongo-driver/v2 v2.8.0
+)
+
+require github.com/google/go-cmp v0.7.0 // indirect
+
+replace github.com/dendianugerah/jagain => ..
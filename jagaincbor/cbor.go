@@ -0,0 +1,106 @@
+// Package jagaincbor encodes jagain Options and Results with github.com/fxamacker/cbor.
+//
+// The cbor package only calls encoding methods defined on the type itself, so fields that
+// cross CBOR use the Option and Result wrappers from this package. They embed the jagain
+// types, so every other method is still available:
+//
+//	type Reading struct {
+//		Value jagaincbor.Option[int] `cbor:"value"`
+//	}
+//
+//	reading := Reading{Value: jagaincbor.Option[int]{jagain.Some(21)}}
+package jagaincbor
+
+import (
+	"bytes"
+	"errors"
+
+	"github.com/dendianugerah/jagain"
+	"github.com/fxamacker/cbor/v2"
+)
+
+// Option wraps a jagain.Option to encode None as CBOR null.
+type Option[T any] struct {
+	jagain.Option[T]
+}
+
+// Result wraps a jagain.Result to encode it as {"ok": value} or {"err": message}.
+type Result[T any] struct {
+	jagain.Result[T]
+}
+
+// cborNull is the CBOR encoding of null.
+var cborNull = []byte{0xf6}
+
+// MarshalCBOR implements the cbor.Marshaler interface.
+// None is encoded as CBOR null.
+func (o Option[T]) MarshalCBOR() ([]byte, error) {
+	if o.IsNone() {
+		return cborNull, nil
+	}
+	return cbor.Marshal(o.Unwrap())
+}
+
+// UnmarshalCBOR implements the cbor.Unmarshaler interface.
+// CBOR null decodes to None.
+func (o *Option[T]) UnmarshalCBOR(data []byte) error {
+	if bytes.Equal(data, cborNull) {
+		o.Option = jagain.None[T]()
+		return nil
+	}
+
+	var value T
+	if err := cbor.Unmarshal(data, &value); err != nil {
+		return err
+	}
+
+	o.Option = jagain.Some(value)
+	return nil
+}
+
+// cborResult is the wire form of a Result: exactly one of the fields is present.
+type cborResult struct {
+	Ok  cbor.RawMessage `cbor:"ok,omitempty"`
+	Err *string         `cbor:"err,omitempty"`
+}
+
+// MarshalCBOR implements the cbor.Marshaler interface.
+// An Ok result is encoded as {"ok": value} and an Err result as {"err": message}.
+func (r Result[T]) MarshalCBOR() ([]byte, error) {
+	if r.IsOk() {
+		value, err := cbor.Marshal(r.Unwrap())
+		if err != nil {
+			return nil, err
+		}
+		return cbor.Marshal(cborResult{Ok: value})
+	}
+
+	var msg string
+	if err := r.UnwrapErr(); err != nil {
+		msg = err.Error()
+	}
+	return cbor.Marshal(cborResult{Err: &msg})
+}
+
+// UnmarshalCBOR implements the cbor.Unmarshaler interface.
+// A decoded error carries the original message but not the original error type.
+func (r *Result[T]) UnmarshalCBOR(data []byte) error {
+	var wire cborResult
+	if err := cbor.Unmarshal(data, &wire); err != nil {
+		return err
+	}
+
+	switch {
+	case wire.Err != nil:
+		r.Result = jagain.Err[T](errors.New(*wire.Err))
+	case wire.Ok != nil:
+		var value T
+		if err := cbor.Unmarshal(wire.Ok, &value); err != nil {
+			return err
+		}
+		r.Result = jagain.Ok(value)
+	default:
+		return errors.New("cbor: result has neither ok nor err")
+	}
+	return nil
+}
@@ -0,0 +1,62 @@
+package jagaincbor
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/dendianugerah/jagain"
+	"github.com/fxamacker/cbor/v2"
+)
+
+type cborReading struct {
+	Sensor string         `cbor:"sensor"`
+	Value  Option[int]    `cbor:"value"`
+	Unit   Option[string] `cbor:"unit"`
+	Status Result[bool]   `cbor:"status"`
+	Cause  Result[bool]   `cbor:"cause"`
+}
+
+func TestCBOR(t *testing.T) {
+	// Test None is encoded as null
+	data, err := cbor.Marshal(Option[int]{jagain.None[int]()})
+	if err != nil || len(data) != 1 || data[0] != 0xf6 {
+		t.Errorf("Expected None to be encoded as CBOR null, got %x (%v)", data, err)
+	}
+
+	// Test round trip of a struct
+	reading := cborReading{
+		Sensor: "t1",
+		Value:  Option[int]{jagain.Some(21)},
+		Unit:   Option[string]{jagain.None[string]()},
+		Status: Result[bool]{jagain.Ok(true)},
+		Cause:  Result[bool]{jagain.Err[bool](errors.New("offline"))},
+	}
+	data, err = cbor.Marshal(reading)
+	if err != nil {
+		t.Fatalf("Failed to marshal reading: %v", err)
+	}
+
+	var decoded cborReading
+	if err := cbor.Unmarshal(data, &decoded); err != nil {
+		t.Fatalf("Failed to unmarshal reading: %v", err)
+	}
+	if decoded.Value.UnwrapOr(0) != 21 {
+		t.Errorf("Expected Value to be Some(21), got %v", decoded.Value)
+	}
+	if !decoded.Unit.IsNone() {
+		t.Errorf("Expected Unit to be None, got %v", decoded.Unit)
+	}
+	if !decoded.Status.IsOk() || !decoded.Status.Unwrap() {
+		t.Errorf("Expected Status to be Ok(true), got %v", decoded.Status)
+	}
+	if !decoded.Cause.IsErr() || decoded.Cause.UnwrapErr().Error() != "offline" {
+		t.Errorf("Expected Cause to be Err(offline), got %v", decoded.Cause)
+	}
+
+	// Test decoding a malformed result
+	data, _ = cbor.Marshal(map[string]int{})
+	var bad Result[int]
+	if err := cbor.Unmarshal(data, &bad); err == nil {
+		t.Errorf("Expected empty result map to fail")
+	}
+}
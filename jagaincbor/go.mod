@@ -0,0 +1,15 @@
+module github.com/dendianugerah/jagain/jagaincbor
+
+go 1.23
+
+require (
+	github.com/dendianugerah/jagain v0.0.0-00010101000000-000000000000
+	github.com/fxamacker/cbor/v2 v2.9.4
+)
+
+require (
+	github.com/google/go-cmp v0.7.0 // indirect
+	github.com/x448/float16 v0.8.4 // indirect
+)
+
+replace github.com/dendianugerah/jagain => ..
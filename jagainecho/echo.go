@@ -0,0 +1,40 @@
+// Package jagainecho adapts jagain's request binding and Result rendering to Echo.
+package jagainecho
+
+import (
+	"github.com/dendianugerah/jagain"
+	"github.com/labstack/echo/v4"
+)
+
+// Bind decodes and validates the request of c into T like jagain.Bind, filling fields
+// tagged `path:"name"` from the route's parameters.
+func Bind[T any](c echo.Context) jagain.Result[T] {
+	names, values := c.ParamNames(), c.ParamValues()
+	params := make(map[string]string, len(names))
+	for i, name := range names {
+		if i < len(values) {
+			params[name] = values[i]
+		}
+	}
+	return jagain.BindParams[T](c.Request(), params)
+}
+
+// Render writes r like jagain.Render: an Ok value as JSON with status 200, and an Err
+// through jagain.DefaultErrorEncoder. It returns nil so that Echo's error handler does
+// not write a second response.
+func Render[T any](c echo.Context, r jagain.Result[T]) error {
+	jagain.Render(c.Response(), c.Request(), r)
+	return nil
+}
+
+// Handler adapts f to an echo.HandlerFunc that renders its Result with Render.
+// Every call is reported to the installed jagain.Metrics, labeled with the matched route.
+func Handler[T any](f func(echo.Context) jagain.Result[T]) echo.HandlerFunc {
+	return func(c echo.Context) error {
+		label := "http " + c.Request().Method
+		if route := c.Path(); route != "" {
+			label += " " + route
+		}
+		return Render(c, jagain.Observe(label, func() jagain.Result[T] { return f(c) }))
+	}
+}
@@ -0,0 +1,62 @@
+package jagainecho
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/dendianugerah/jagain"
+	"github.com/labstack/echo/v4"
+)
+
+type updateUser struct {
+	ID   int                   `path:"id" json:"-"`
+	Name jagain.Option[string] `json:"name"`
+}
+
+func TestHandler(t *testing.T) {
+	e := echo.New()
+	e.PATCH("/users/:id", Handler(func(c echo.Context) jagain.Result[updateUser] {
+		return jagain.FlatMapTo(Bind[updateUser](c), func(u updateUser) jagain.Result[updateUser] {
+			if u.ID == 0 {
+				return jagain.Err[updateUser](jagain.ErrNoValue)
+			}
+			return jagain.Ok(u)
+		})
+	}))
+
+	tests := []struct {
+		path   string
+		body   string
+		status int
+		want   string
+	}{
+		{"/users/42", `{"name":"Jane"}`, http.StatusOK, `{"name":"Jane"}`},
+		{"/users/x", `{}`, http.StatusBadRequest, `{"error":"id: `},
+		{"/users/0", `{}`, http.StatusNotFound, `{"error":"option contains no value"}`},
+		{"/users/42", `{"nickname":"J"}`, http.StatusBadRequest, `{"error":"nickname: `},
+	}
+	for _, tt := range tests {
+		req := httptest.NewRequest(http.MethodPatch, tt.path, strings.NewReader(tt.body))
+		req.Header.Set("Content-Type", "application/json")
+		rec := httptest.NewRecorder()
+		e.ServeHTTP(rec, req)
+		if rec.Code != tt.status || !strings.HasPrefix(rec.Body.String(), tt.want) {
+			t.Errorf("Expected %d %s for %s %s, got %d %s", tt.status, tt.want, tt.path, tt.body, rec.Code, rec.Body.String())
+		}
+	}
+}
+
+func TestRender(t *testing.T) {
+	e := echo.New()
+	rec := httptest.NewRecorder()
+	c := e.NewContext(httptest.NewRequest(http.MethodGet, "/", nil), rec)
+	if err := Render(c, jagain.Err[int](errors.New("database down"))); err != nil {
+		t.Errorf("Expected Render to handle the error itself, got %v", err)
+	}
+	if rec.Code != http.StatusInternalServerError || rec.Header().Get("Content-Type") != "application/json" {
+		t.Errorf("Expected a 500 JSON error, got %d %s", rec.Code, rec.Header().Get("Content-Type"))
+	}
+}
@@ -0,0 +1,41 @@
+// Package jagainfiber adapts jagain's request binding and Result rendering to Fiber.
+package jagainfiber
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/dendianugerah/jagain"
+	"github.com/gofiber/fiber/v2"
+	"github.com/gofiber/fiber/v2/middleware/adaptor"
+)
+
+// Bind decodes and validates the request of c into T like jagain.Bind, filling fields
+// tagged `path:"name"` from the route's parameters.
+func Bind[T any](c *fiber.Ctx) jagain.Result[T] {
+	req, err := adaptor.ConvertRequest(c, false)
+	if err != nil {
+		return jagain.Err[T](fmt.Errorf("bind: %w", err))
+	}
+	return jagain.BindParams[T](req, c.AllParams())
+}
+
+// Render writes r like jagain.Render: an Ok value as JSON with status 200, and an Err
+// through jagain.DefaultErrorEncoder, which sees the request as an *http.Request.
+func Render[T any](c *fiber.Ctx, r jagain.Result[T]) error {
+	return adaptor.HTTPHandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		jagain.Render(w, req, r)
+	})(c)
+}
+
+// Handler adapts f to a fiber.Handler that renders its Result with Render.
+// Every call is reported to the installed jagain.Metrics, labeled with the matched route.
+func Handler[T any](f func(*fiber.Ctx) jagain.Result[T]) fiber.Handler {
+	return func(c *fiber.Ctx) error {
+		label := "http " + c.Method()
+		if route := c.Route().Path; route != "" {
+			label += " " + route
+		}
+		return Render(c, jagain.Observe(label, func() jagain.Result[T] { return f(c) }))
+	}
+}
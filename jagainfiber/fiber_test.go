@@ -0,0 +1,63 @@
+package jagainfiber
+
+import (
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/dendianugerah/jagain"
+	"github.com/gofiber/fiber/v2"
+)
+
+type updateUser struct {
+	ID   int                   `path:"id" json:"-"`
+	Name jagain.Option[string] `json:"name"`
+}
+
+func TestHandler(t *testing.T) {
+	app := fiber.New()
+	app.Patch("/users/:id", Handler(func(c *fiber.Ctx) jagain.Result[updateUser] {
+		return jagain.FlatMapTo(Bind[updateUser](c), func(u updateUser) jagain.Result[updateUser] {
+			if u.ID == 0 {
+				return jagain.Err[updateUser](jagain.ErrNoValue)
+			}
+			return jagain.Ok(u)
+		})
+	}))
+	app.Get("/fail", func(c *fiber.Ctx) error {
+		return Render(c, jagain.Err[int](errors.New("database down")))
+	})
+
+	tests := []struct {
+		method string
+		path   string
+		body   string
+		status int
+		want   string
+	}{
+		{http.MethodPatch, "/users/42", `{"name":"Jane"}`, http.StatusOK, `{"name":"Jane"}`},
+		{http.MethodPatch, "/users/x", `{}`, http.StatusBadRequest, `{"error":"id: `},
+		{http.MethodPatch, "/users/0", `{}`, http.StatusNotFound, `{"error":"option contains no value"}`},
+		{http.MethodPatch, "/users/42", `{"nickname":"J"}`, http.StatusBadRequest, `{"error":"nickname: `},
+		{http.MethodGet, "/fail", "", http.StatusInternalServerError, `{"error":"Internal Server Error"}`},
+	}
+	for _, tt := range tests {
+		req := httptest.NewRequest(tt.method, tt.path, strings.NewReader(tt.body))
+		req.Header.Set("Content-Type", "application/json")
+		resp, err := app.Test(req)
+		if err != nil {
+			t.Fatalf("Failed to send %s %s: %v", tt.method, tt.path, err)
+		}
+		body, _ := io.ReadAll(resp.Body)
+		resp.Body.Close()
+		if resp.StatusCode != tt.status || !strings.HasPrefix(string(body), tt.want) {
+			t.Errorf("Expected %d %s for %s %s, got %d %s", tt.status, tt.want, tt.path, tt.body, resp.StatusCode, body)
+		}
+		if resp.Header.Get("Content-Type") != "application/json" {
+			t.Errorf("Expected JSON content type for %s, got %q", tt.path, resp.Header.Get("Content-Type"))
+		}
+	}
+}
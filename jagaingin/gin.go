@@ -0,0 +1,35 @@
+// Package jagaingin adapts jagain's request binding and Result rendering to Gin.
+package jagaingin
+
+import (
+	"github.com/dendianugerah/jagain"
+	"github.com/gin-gonic/gin"
+)
+
+// Bind decodes and validates the request of c into T like jagain.Bind, filling fields
+// tagged `path:"name"` from the route's parameters.
+func Bind[T any](c *gin.Context) jagain.Result[T] {
+	params := make(map[string]string, len(c.Params))
+	for _, p := range c.Params {
+		params[p.Key] = p.Value
+	}
+	return jagain.BindParams[T](c.Request, params)
+}
+
+// Render writes r like jagain.Render: an Ok value as JSON with status 200, and an Err
+// through jagain.DefaultErrorEncoder.
+func Render[T any](c *gin.Context, r jagain.Result[T]) {
+	jagain.Render(c.Writer, c.Request, r)
+}
+
+// Handler adapts f to a gin.HandlerFunc that renders its Result with Render.
+// Every call is reported to the installed jagain.Metrics, labeled with the matched route.
+func Handler[T any](f func(*gin.Context) jagain.Result[T]) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		label := "http " + c.Request.Method
+		if route := c.FullPath(); route != "" {
+			label += " " + route
+		}
+		Render(c, jagain.Observe(label, func() jagain.Result[T] { return f(c) }))
+	}
+}
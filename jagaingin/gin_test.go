@@ -0,0 +1,62 @@
+package jagaingin
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/dendianugerah/jagain"
+	"github.com/gin-gonic/gin"
+)
+
+type updateUser struct {
+	ID   int                   `path:"id" json:"-"`
+	Name jagain.Option[string] `json:"name"`
+}
+
+func TestHandler(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	r := gin.New()
+	r.PATCH("/users/:id", Handler(func(c *gin.Context) jagain.Result[updateUser] {
+		return jagain.FlatMapTo(Bind[updateUser](c), func(u updateUser) jagain.Result[updateUser] {
+			if u.ID == 0 {
+				return jagain.Err[updateUser](jagain.ErrNoValue)
+			}
+			return jagain.Ok(u)
+		})
+	}))
+
+	tests := []struct {
+		path   string
+		body   string
+		status int
+		want   string
+	}{
+		{"/users/42", `{"name":"Jane"}`, http.StatusOK, `{"name":"Jane"}`},
+		{"/users/x", `{}`, http.StatusBadRequest, `{"error":"id: `},
+		{"/users/0", `{}`, http.StatusNotFound, `{"error":"option contains no value"}`},
+		{"/users/42", `{"nickname":"J"}`, http.StatusBadRequest, `{"error":"nickname: `},
+	}
+	for _, tt := range tests {
+		req := httptest.NewRequest(http.MethodPatch, tt.path, strings.NewReader(tt.body))
+		req.Header.Set("Content-Type", "application/json")
+		rec := httptest.NewRecorder()
+		r.ServeHTTP(rec, req)
+		if rec.Code != tt.status || !strings.HasPrefix(rec.Body.String(), tt.want) {
+			t.Errorf("Expected %d %s for %s %s, got %d %s", tt.status, tt.want, tt.path, tt.body, rec.Code, rec.Body.String())
+		}
+	}
+}
+
+func TestRender(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	rec := httptest.NewRecorder()
+	c, _ := gin.CreateTestContext(rec)
+	c.Request = httptest.NewRequest(http.MethodGet, "/", nil)
+	Render(c, jagain.Err[int](errors.New("database down")))
+	if rec.Code != http.StatusInternalServerError || rec.Header().Get("Content-Type") != "application/json" {
+		t.Errorf("Expected a 500 JSON error, got %d %s", rec.Code, rec.Header().Get("Content-Type"))
+	}
+}
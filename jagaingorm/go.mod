@@ -0,0 +1,17 @@
+module github.com/dendianugerah/jagain/jagaingorm
+
+go 1.23
+
+require (
+	github.com/dendianugerah/jagain v0.0.0-00010101000000-000000000000
+	gorm.io/gorm v1.31.2
+)
+
+require (
+	github.com/google/go-cmp v0.7.0 // indirect
+	github.com/jinzhu/inflection v1.0.0 // indirect
+	github.com/jinzhu/now v1.1.5 // indirect
+	golang.org/x/text v0.20.0 // indirect
+)
+
+replace github.com/dendianugerah/jagain => ..
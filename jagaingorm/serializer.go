@@ -0,0 +1,68 @@
+// Package jagaingorm integrates jagain Options with GORM.
+//
+// Option columns work with GORM out of the box through Option's sql.Scanner, driver.Valuer
+// and GormDataType implementations. Because None is a zero value and Some never is,
+// Updates with a struct skips None fields and writes Some fields even when they hold a zero
+// value such as Some(0) or Some(""). To store NULL explicitly, pass None in a map update.
+//
+// Importing this package also registers Serializer under the name "jagain", for Option
+// fields whose value should be stored as JSON:
+//
+//	type User struct {
+//		Settings jagain.Option[Settings] `gorm:"serializer:jagain"`
+//	}
+package jagaingorm
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"reflect"
+
+	"gorm.io/gorm/schema"
+)
+
+// SerializerName is the name Serializer is registered under.
+const SerializerName = "jagain"
+
+func init() {
+	schema.RegisterSerializer(SerializerName, Serializer{})
+}
+
+// Serializer stores an Option field's value as JSON and None as NULL.
+// Unlike GORM's json serializer, None is not written as the JSON text null.
+type Serializer struct{}
+
+// Scan implements schema.SerializerInterface.
+func (Serializer) Scan(ctx context.Context, field *schema.Field, dst reflect.Value, dbValue any) error {
+	target := reflect.New(field.FieldType)
+	if dbValue != nil {
+		var data []byte
+		switch v := dbValue.(type) {
+		case []byte:
+			data = v
+		case string:
+			data = []byte(v)
+		default:
+			return fmt.Errorf("jagaingorm: cannot scan %T into %s", dbValue, field.Name)
+		}
+		if err := json.Unmarshal(data, target.Interface()); err != nil {
+			return err
+		}
+	}
+
+	field.ReflectValueOf(ctx, dst).Set(target.Elem())
+	return nil
+}
+
+// Value implements schema.SerializerValuerInterface.
+func (Serializer) Value(ctx context.Context, field *schema.Field, dst reflect.Value, fieldValue any) (any, error) {
+	if z, ok := fieldValue.(interface{ IsZero() bool }); ok && z.IsZero() {
+		return nil, nil
+	}
+	data, err := json.Marshal(fieldValue)
+	if err != nil {
+		return nil, err
+	}
+	return string(data), nil
+}
@@ -0,0 +1,80 @@
+package jagaingorm
+
+import (
+	"context"
+	"reflect"
+	"sync"
+	"testing"
+
+	"github.com/dendianugerah/jagain"
+	"gorm.io/gorm/schema"
+)
+
+type settings struct {
+	Theme string `json:"theme"`
+}
+
+type user struct {
+	ID       uint
+	Nickname jagain.Option[string]
+	Age      jagain.Option[int]
+	Settings jagain.Option[settings] `gorm:"serializer:jagain"`
+}
+
+func parseUser(t *testing.T) *schema.Schema {
+	t.Helper()
+	s, err := schema.Parse(&user{}, &sync.Map{}, schema.NamingStrategy{})
+	if err != nil {
+		t.Fatalf("Failed to parse schema: %v", err)
+	}
+	return s
+}
+
+func TestSchema(t *testing.T) {
+	s := parseUser(t)
+
+	if dt := s.LookUpField("Nickname").DataType; dt != schema.String {
+		t.Errorf("Expected Nickname to have data type string, got %q", dt)
+	}
+	if dt := s.LookUpField("Age").DataType; dt != schema.Int {
+		t.Errorf("Expected Age to have data type int, got %q", dt)
+	}
+	if s.LookUpField("Settings").Serializer == nil {
+		t.Errorf("Expected Settings to use the jagain serializer")
+	}
+}
+
+func TestSerializer(t *testing.T) {
+	ctx := context.Background()
+	field := parseUser(t).LookUpField("Settings")
+
+	// Test Value
+	u := user{Settings: jagain.Some(settings{Theme: "dark"})}
+	v, err := Serializer{}.Value(ctx, field, reflect.ValueOf(&u), u.Settings)
+	if err != nil || v != `{"theme":"dark"}` {
+		t.Errorf("Expected Some to be stored as JSON, got %v (%v)", v, err)
+	}
+	v, err = Serializer{}.Value(ctx, field, reflect.ValueOf(&u), jagain.None[settings]())
+	if err != nil || v != nil {
+		t.Errorf("Expected None to be stored as NULL, got %v (%v)", v, err)
+	}
+
+	// Test Scan
+	var scanned user
+	dst := reflect.ValueOf(&scanned)
+	if err := (Serializer{}).Scan(ctx, field, dst, []byte(`{"theme":"light"}`)); err != nil {
+		t.Fatalf("Failed to scan JSON: %v", err)
+	}
+	if scanned.Settings.UnwrapOr(settings{}).Theme != "light" {
+		t.Errorf("Expected JSON to scan as Some, got %v", scanned.Settings)
+	}
+	if err := (Serializer{}).Scan(ctx, field, dst, nil); err != nil {
+		t.Fatalf("Failed to scan NULL: %v", err)
+	}
+	if !scanned.Settings.IsNone() {
+		t.Errorf("Expected NULL to scan as None, got %v", scanned.Settings)
+	}
+	if err := (Serializer{}).Scan(ctx, field, dst, 42); err == nil {
+		t.Errorf("Expected scanning an int to fail")
+	}
+}
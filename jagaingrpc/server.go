@@ -0,0 +1,52 @@
+package jagaingrpc
+
+import (
+	"context"
+
+	"github.com/dendianugerah/jagain"
+	"google.golang.org/grpc"
+)
+
+// Handler adapts a service method returning a Result to the signature of a generated gRPC
+// server method, using the DefaultRegistry to turn an Err into a status:
+//
+//	func (s *server) GetUser(ctx context.Context, req *pb.GetUserRequest) (*pb.User, error) {
+//		return jagaingrpc.Handler(s.getUser)(ctx, req)
+//	}
+func Handler[Req, Resp any](f func(context.Context, Req) jagain.Result[Resp]) func(context.Context, Req) (Resp, error) {
+	return HandlerWith(DefaultRegistry, f)
+}
+
+// HandlerWith is like Handler but uses reg to pick status codes.
+// A panic in f is recovered and reported as an error status instead of crashing the server.
+func HandlerWith[Req, Resp any](reg *Registry, f func(context.Context, Req) jagain.Result[Resp]) func(context.Context, Req) (Resp, error) {
+	return func(ctx context.Context, req Req) (Resp, error) {
+		return ToGRPCWith(reg, jagain.Recovered(func() jagain.Result[Resp] {
+			return f(ctx, req)
+		}))
+	}
+}
+
+// UnaryServerInterceptor returns an interceptor that converts errors returned by unary
+// handlers into statuses using reg, so handlers can return plain errors or use
+// jagain.Result internally without converting each error themselves. Errors that already
+// carry a status are passed through.
+func UnaryServerInterceptor(reg *Registry) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		resp, err := handler(ctx, req)
+		if err != nil {
+			return resp, reg.Status(err).Err()
+		}
+		return resp, nil
+	}
+}
+
+// StreamServerInterceptor returns the streaming counterpart of UnaryServerInterceptor.
+func StreamServerInterceptor(reg *Registry) grpc.StreamServerInterceptor {
+	return func(srv any, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		if err := handler(srv, ss); err != nil {
+			return reg.Status(err).Err()
+		}
+		return nil
+	}
+}
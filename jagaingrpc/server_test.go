@@ -0,0 +1,113 @@
+package jagaingrpc
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"testing"
+
+	"github.com/dendianugerah/jagain"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/grpc/status"
+	"google.golang.org/grpc/test/bufconn"
+)
+
+// healthServer implements the health service with a Result-returning method.
+type healthServer struct {
+	grpc_health_v1.UnimplementedHealthServer
+}
+
+func (s *healthServer) check(ctx context.Context, req *grpc_health_v1.HealthCheckRequest) jagain.Result[*grpc_health_v1.HealthCheckResponse] {
+	switch req.Service {
+	case "":
+		return jagain.Ok(&grpc_health_v1.HealthCheckResponse{Status: grpc_health_v1.HealthCheckResponse_SERVING})
+	case "denied":
+		return jagain.Err[*grpc_health_v1.HealthCheckResponse](fmt.Errorf("checking: %w", errDenied))
+	case "panic":
+		panic("boom")
+	}
+	return jagain.OptionToNotFound(jagain.None[*grpc_health_v1.HealthCheckResponse](), "service", req.Service)
+}
+
+func (s *healthServer) Check(ctx context.Context, req *grpc_health_v1.HealthCheckRequest) (*grpc_health_v1.HealthCheckResponse, error) {
+	return HandlerWith(testRegistry, s.check)(ctx, req)
+}
+
+var testRegistry = func() *Registry {
+	reg := NewRegistry()
+	reg.Register(errDenied, codes.PermissionDenied)
+	return reg
+}()
+
+func TestHandler(t *testing.T) {
+	lis := bufconn.Listen(1 << 20)
+	srv := grpc.NewServer()
+	grpc_health_v1.RegisterHealthServer(srv, &healthServer{})
+	go srv.Serve(lis)
+	defer srv.Stop()
+
+	conn, err := grpc.NewClient("passthrough:///bufnet",
+		grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) { return lis.DialContext(ctx) }),
+		grpc.WithTransportCredentials(insecure.NewCredentials()))
+	if err != nil {
+		t.Fatalf("Failed to dial: %v", err)
+	}
+	defer conn.Close()
+	client := grpc_health_v1.NewHealthClient(conn)
+
+	// Test Ok becomes the response
+	resp, err := client.Check(context.Background(), &grpc_health_v1.HealthCheckRequest{})
+	if err != nil || resp.Status != grpc_health_v1.HealthCheckResponse_SERVING {
+		t.Errorf("Expected SERVING, got %v (%v)", resp, err)
+	}
+
+	// Test Err is mapped through the registry
+	tests := map[string]codes.Code{
+		"denied":  codes.PermissionDenied,
+		"missing": codes.NotFound,
+		"panic":   codes.Unknown,
+	}
+	for service, want := range tests {
+		_, err := client.Check(context.Background(), &grpc_health_v1.HealthCheckRequest{Service: service})
+		if status.Code(err) != want {
+			t.Errorf("Expected %v for %q, got %v", want, service, status.Code(err))
+		}
+	}
+}
+
+func TestServerInterceptors(t *testing.T) {
+	// Test plain errors are converted
+	unary := UnaryServerInterceptor(testRegistry)
+	_, err := unary(context.Background(), nil, &grpc.UnaryServerInfo{}, func(ctx context.Context, req any) (any, error) {
+		return nil, fmt.Errorf("loading: %w", errDenied)
+	})
+	if status.Code(err) != codes.PermissionDenied || status.Convert(err).Message() != "loading: denied" {
+		t.Errorf("Expected PermissionDenied, got %v", err)
+	}
+
+	// Test existing statuses and successes pass through
+	_, err = unary(context.Background(), nil, &grpc.UnaryServerInfo{}, func(ctx context.Context, req any) (any, error) {
+		return nil, status.Error(codes.Aborted, "retry")
+	})
+	if status.Code(err) != codes.Aborted {
+		t.Errorf("Expected Aborted, got %v", err)
+	}
+	resp, err := unary(context.Background(), nil, &grpc.UnaryServerInfo{}, func(ctx context.Context, req any) (any, error) {
+		return "ok", nil
+	})
+	if err != nil || resp != "ok" {
+		t.Errorf("Expected the response to pass through, got %v (%v)", resp, err)
+	}
+
+	// Test streams
+	stream := StreamServerInterceptor(testRegistry)
+	err = stream(nil, nil, &grpc.StreamServerInfo{}, func(srv any, ss grpc.ServerStream) error {
+		return context.DeadlineExceeded
+	})
+	if status.Code(err) != codes.DeadlineExceeded {
+		t.Errorf("Expected DeadlineExceeded, got %v", err)
+	}
+}
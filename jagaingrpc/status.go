@@ -0,0 +1,174 @@
+// Package jagaingrpc maps jagain Results to and from gRPC statuses.
+package jagaingrpc
+
+import (
+	"context"
+	"errors"
+	"sync"
+
+	"github.com/dendianugerah/jagain"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type rule struct {
+	target error
+	code   codes.Code
+}
+
+// Registry maps errors to gRPC status codes.
+// Errors carrying a jagain error code registered with RegisterCode are matched first;
+// otherwise errors are matched with errors.Is against registered targets, in registration order.
+type Registry struct {
+	mu       sync.RWMutex
+	rules    []rule
+	byCode   map[string]codes.Code
+	fallback codes.Code
+}
+
+// NewRegistry creates a Registry that maps context cancellation and deadline errors,
+// and jagain.ErrNoValue and jagain.ErrNotFound to NotFound. Unmatched errors map to codes.Unknown.
+func NewRegistry() *Registry {
+	reg := &Registry{fallback: codes.Unknown}
+	reg.Register(context.Canceled, codes.Canceled)
+	reg.Register(context.DeadlineExceeded, codes.DeadlineExceeded)
+	reg.Register(jagain.ErrNoValue, codes.NotFound)
+	reg.Register(jagain.ErrNotFound, codes.NotFound)
+	return reg
+}
+
+// DefaultRegistry is the Registry used by ToGRPC, ToStatus and FromGRPCError.
+var DefaultRegistry = NewRegistry()
+
+// Register maps errors matching target to code.
+func (reg *Registry) Register(target error, code codes.Code) {
+	reg.mu.Lock()
+	defer reg.mu.Unlock()
+	reg.rules = append(reg.rules, rule{target: target, code: code})
+}
+
+// RegisterCode maps errors carrying the jagain error code errCode to code.
+func (reg *Registry) RegisterCode(errCode string, code codes.Code) {
+	reg.mu.Lock()
+	defer reg.mu.Unlock()
+	if reg.byCode == nil {
+		reg.byCode = map[string]codes.Code{}
+	}
+	reg.byCode[errCode] = code
+}
+
+// SetFallback sets the code used for errors that match no registered target.
+func (reg *Registry) SetFallback(code codes.Code) {
+	reg.mu.Lock()
+	defer reg.mu.Unlock()
+	reg.fallback = code
+}
+
+// Code returns the gRPC code for err.
+// Errors that already carry a gRPC status keep their code.
+func (reg *Registry) Code(err error) codes.Code {
+	if err == nil {
+		return codes.OK
+	}
+	if s, ok := status.FromError(err); ok {
+		return s.Code()
+	}
+
+	reg.mu.RLock()
+	defer reg.mu.RUnlock()
+	if errCode := jagain.ErrorCode(err); errCode.IsSome() {
+		if code, ok := reg.byCode[errCode.Unwrap()]; ok {
+			return code
+		}
+	}
+	for _, r := range reg.rules {
+		if errors.Is(err, r.target) {
+			return r.code
+		}
+	}
+	return reg.fallback
+}
+
+// Status converts err to a gRPC status using the registered code mapping.
+func (reg *Registry) Status(err error) *status.Status {
+	if s, ok := status.FromError(err); ok {
+		return s
+	}
+	return status.New(reg.Code(err), err.Error())
+}
+
+// target returns the first registered error for code, used to rebuild errors from statuses.
+func (reg *Registry) target(code codes.Code) error {
+	reg.mu.RLock()
+	defer reg.mu.RUnlock()
+	for _, r := range reg.rules {
+		if r.code == code {
+			return r.target
+		}
+	}
+	return nil
+}
+
+// Error is the error carried by Results built from gRPC errors.
+// It exposes the original status and unwraps to the registered error for the status code,
+// so errors.Is keeps working across the RPC boundary.
+type Error struct {
+	status *status.Status
+	target error
+}
+
+// Error implements the error interface.
+func (e *Error) Error() string {
+	return e.status.Message()
+}
+
+// GRPCStatus returns the gRPC status the error was built from.
+func (e *Error) GRPCStatus() *status.Status {
+	return e.status
+}
+
+// Unwrap returns the registered error for the status code, if any.
+func (e *Error) Unwrap() error {
+	return e.target
+}
+
+// ToStatus converts err to a gRPC status using the DefaultRegistry.
+func ToStatus(err error) *status.Status {
+	return DefaultRegistry.Status(err)
+}
+
+// ToGRPC converts a Result into the value and error pair returned by gRPC handlers,
+// using the DefaultRegistry to pick the status code.
+func ToGRPC[T any](r jagain.Result[T]) (T, error) {
+	return ToGRPCWith(DefaultRegistry, r)
+}
+
+// ToGRPCWith converts a Result into the value and error pair returned by gRPC handlers,
+// using reg to pick the status code.
+func ToGRPCWith[T any](reg *Registry, r jagain.Result[T]) (T, error) {
+	if r.IsOk() {
+		return r.Unwrap(), nil
+	}
+	var zero T
+	return zero, reg.Status(r.UnwrapErr()).Err()
+}
+
+// FromGRPC converts the value and error pair returned by a gRPC client call into a Result.
+func FromGRPC[T any](value T, err error) jagain.Result[T] {
+	if err != nil {
+		return FromGRPCError[T](err)
+	}
+	return jagain.Ok(value)
+}
+
+// FromGRPCError converts a gRPC error into an Err result carrying an *Error.
+func FromGRPCError[T any](err error) jagain.Result[T] {
+	return FromGRPCErrorWith[T](DefaultRegistry, err)
+}
+
+// FromGRPCErrorWith converts a gRPC error into an Err result carrying an *Error,
+// using reg to find the error registered for the status code.
+func FromGRPCErrorWith[T any](reg *Registry, err error) jagain.Result[T] {
+	s := status.Convert(err)
+	return jagain.Err[T](&Error{status: s, target: reg.target(s.Code())})
+}
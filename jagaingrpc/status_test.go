@@ -0,0 +1,92 @@
+package jagaingrpc
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/dendianugerah/jagain"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+var errDenied = errors.New("denied")
+
+func TestToGRPC(t *testing.T) {
+	reg := NewRegistry()
+	reg.Register(errDenied, codes.PermissionDenied)
+
+	// Test Ok passes through
+	v, err := ToGRPCWith(reg, jagain.Ok("hello"))
+	if err != nil || v != "hello" {
+		t.Errorf("Expected Ok to return the value, got %q (%v)", v, err)
+	}
+
+	// Test registered errors, including wrapped ones
+	_, err = ToGRPCWith(reg, jagain.Err[string](fmt.Errorf("loading: %w", errDenied)))
+	if status.Code(err) != codes.PermissionDenied {
+		t.Errorf("Expected PermissionDenied, got %v", status.Code(err))
+	}
+	if status.Convert(err).Message() != "loading: denied" {
+		t.Errorf("Expected the error message to be kept, got %q", status.Convert(err).Message())
+	}
+
+	// Test built-in mappings
+	_, err = ToGRPCWith(reg, jagain.Err[string](context.DeadlineExceeded))
+	if status.Code(err) != codes.DeadlineExceeded {
+		t.Errorf("Expected DeadlineExceeded, got %v", status.Code(err))
+	}
+	_, err = ToGRPCWith(reg, jagain.None[string]().ToResult(jagain.ErrNoValue))
+	if status.Code(err) != codes.NotFound {
+		t.Errorf("Expected NotFound, got %v", status.Code(err))
+	}
+	_, err = ToGRPCWith(reg, jagain.OptionToNotFound(jagain.None[string](), "user", 42))
+	if status.Code(err) != codes.NotFound {
+		t.Errorf("Expected NotFound for a NotFoundError, got %v", status.Code(err))
+	}
+
+	// Test error codes take precedence over registered targets
+	reg.RegisterCode("QUOTA", codes.ResourceExhausted)
+	_, err = ToGRPCWith(reg, jagain.ErrCode[string]("QUOTA", errDenied))
+	if status.Code(err) != codes.ResourceExhausted {
+		t.Errorf("Expected ResourceExhausted, got %v", status.Code(err))
+	}
+
+	// Test fallback and existing statuses
+	_, err = ToGRPCWith(reg, jagain.Err[string](errors.New("other")))
+	if status.Code(err) != codes.Unknown {
+		t.Errorf("Expected Unknown, got %v", status.Code(err))
+	}
+	reg.SetFallback(codes.Internal)
+	if reg.Code(errors.New("other")) != codes.Internal {
+		t.Errorf("Expected fallback to be Internal")
+	}
+	_, err = ToGRPCWith(reg, jagain.Err[string](status.Error(codes.Aborted, "retry")))
+	if status.Code(err) != codes.Aborted {
+		t.Errorf("Expected existing status to be kept, got %v", status.Code(err))
+	}
+}
+
+func TestFromGRPC(t *testing.T) {
+	// Test successful call
+	r := FromGRPC(42, nil)
+	if !r.IsOk() || r.Unwrap() != 42 {
+		t.Errorf("Expected Ok(42), got %v", r)
+	}
+
+	// Test error call keeps the status and unwraps to the registered error
+	r = FromGRPC(0, status.Error(codes.NotFound, "no such user"))
+	if !r.IsErr() {
+		t.Fatalf("Expected Err, got %v", r)
+	}
+	if !errors.Is(r.UnwrapErr(), jagain.ErrNoValue) {
+		t.Errorf("Expected NotFound to unwrap to ErrNoValue")
+	}
+	if status.Code(r.UnwrapErr()) != codes.NotFound {
+		t.Errorf("Expected the status code to be kept, got %v", status.Code(r.UnwrapErr()))
+	}
+	if r.UnwrapErr().Error() != "no such user" {
+		t.Errorf("Expected the status message, got %q", r.UnwrapErr().Error())
+	}
+}
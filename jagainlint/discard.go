@@ -0,0 +1,103 @@
+package jagainlint
+
+import (
+	"go/ast"
+	"go/types"
+
+	"golang.org/x/tools/go/analysis"
+	"golang.org/x/tools/go/analysis/passes/inspect"
+	"golang.org/x/tools/go/ast/inspector"
+)
+
+// DiscardAnalyzer reports calls whose jagain Result is thrown away, either by using the
+// call as a statement or by assigning the Result to the blank identifier. Like an
+// unchecked error, a discarded Result silently loses its failure.
+var DiscardAnalyzer = &analysis.Analyzer{
+	Name:     "resultcheck",
+	Doc:      "report calls whose jagain Result is discarded",
+	Requires: []*analysis.Analyzer{inspect.Analyzer},
+	Run:      runDiscard,
+}
+
+func runDiscard(pass *analysis.Pass) (any, error) {
+	insp := pass.ResultOf[inspect.Analyzer].(*inspector.Inspector)
+	filter := []ast.Node{
+		(*ast.ExprStmt)(nil),
+		(*ast.GoStmt)(nil),
+		(*ast.DeferStmt)(nil),
+		(*ast.AssignStmt)(nil),
+		(*ast.ValueSpec)(nil),
+	}
+	insp.Preorder(filter, func(n ast.Node) {
+		switch n := n.(type) {
+		case *ast.ExprStmt:
+			if call, ok := ast.Unparen(n.X).(*ast.CallExpr); ok {
+				reportDiscarded(pass, call, -1)
+			}
+		case *ast.GoStmt:
+			reportDiscarded(pass, n.Call, -1)
+		case *ast.DeferStmt:
+			reportDiscarded(pass, n.Call, -1)
+		case *ast.AssignStmt:
+			checkBlank(pass, n.Lhs, n.Rhs)
+		case *ast.ValueSpec:
+			lhs := make([]ast.Expr, len(n.Names))
+			for i, name := range n.Names {
+				lhs[i] = name
+			}
+			checkBlank(pass, lhs, n.Values)
+		}
+	})
+	return nil, nil
+}
+
+// checkBlank reports Results from rhs that are assigned to the blank identifier in lhs.
+func checkBlank(pass *analysis.Pass, lhs, rhs []ast.Expr) {
+	for i, l := range lhs {
+		if id, ok := l.(*ast.Ident); !ok || id.Name != "_" {
+			continue
+		}
+		switch {
+		case len(lhs) == len(rhs):
+			if call, ok := ast.Unparen(rhs[i]).(*ast.CallExpr); ok {
+				reportDiscarded(pass, call, -1)
+			}
+		case len(rhs) == 1:
+			if call, ok := ast.Unparen(rhs[0]).(*ast.CallExpr); ok {
+				reportDiscarded(pass, call, i)
+			}
+		}
+	}
+}
+
+// reportDiscarded reports call if the result at index is a jagain Result. An index of -1
+// checks every result.
+func reportDiscarded(pass *analysis.Pass, call *ast.CallExpr, index int) {
+	var results []types.Type
+	switch t := pass.TypesInfo.TypeOf(call).(type) {
+	case nil:
+		return
+	case *types.Tuple:
+		for v := range t.Variables() {
+			results = append(results, v.Type())
+		}
+	default:
+		results = []types.Type{t}
+	}
+	for i, t := range results {
+		if (index < 0 || i == index) && isResult(t) {
+			pass.Reportf(call.Pos(), "Result of %s is discarded", types.ExprString(call.Fun))
+			return
+		}
+	}
+}
+
+// isResult reports whether t is an instance of jagain.Result.
+func isResult(t types.Type) bool {
+	named, ok := t.(*types.Named)
+	if !ok {
+		return false
+	}
+	obj := named.Origin().Obj()
+	return obj.Pkg() != nil && obj.Pkg().Path() == jagainPath && obj.Name() == "Result"
+}
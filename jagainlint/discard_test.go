@@ -0,0 +1,11 @@
+package jagainlint
+
+import (
+	"testing"
+
+	"golang.org/x/tools/go/analysis/analysistest"
+)
+
+func TestDiscardAnalyzer(t *testing.T) {
+	analysistest.Run(t, analysistest.TestData(), DiscardAnalyzer, "discard")
+}
@@ -0,0 +1,189 @@
+package jagainlint
+
+import (
+	"fmt"
+	"go/ast"
+	"go/types"
+	"reflect"
+	"slices"
+	"strconv"
+	"strings"
+	"unicode"
+
+	"golang.org/x/tools/go/analysis"
+	"golang.org/x/tools/go/analysis/passes/inspect"
+	"golang.org/x/tools/go/ast/inspector"
+)
+
+// OptionalAnalyzer reports exported struct fields that use a pointer to mark a value as
+// optional, either with a comment starting with "optional" or with a json omitempty
+// option, and suggests an Option in its place:
+//
+//	Nickname *string `json:"nickname,omitempty"` // becomes
+//	Nickname jagain.Option[string] `json:"nickname,omitzero"`
+//
+// The suggested fix swaps omitempty for omitzero, since only omitzero omits a None, and
+// imports jagain if the file does not already. Code that dereferences the field still has
+// to be updated by hand.
+var OptionalAnalyzer = &analysis.Analyzer{
+	Name:     "optionalptr",
+	Doc:      "report pointer struct fields used as optional values and suggest jagain.Option",
+	Requires: []*analysis.Analyzer{inspect.Analyzer},
+	Run:      runOptional,
+}
+
+func runOptional(pass *analysis.Pass) (any, error) {
+	insp := pass.ResultOf[inspect.Analyzer].(*inspector.Inspector)
+	insp.WithStack([]ast.Node{(*ast.Field)(nil)}, func(n ast.Node, push bool, stack []ast.Node) bool {
+		if !push || len(stack) < 3 {
+			return true
+		}
+		if _, ok := stack[len(stack)-3].(*ast.StructType); !ok {
+			return true
+		}
+		field := n.(*ast.Field)
+		star, ok := field.Type.(*ast.StarExpr)
+		if !ok || !hasExportedName(field) || !markedOptional(field) {
+			return true
+		}
+
+		file := enclosingFile(stack)
+		option := optionName(pass, file)
+		elem := types.ExprString(star.X)
+		fix := analysis.SuggestedFix{
+			Message: fmt.Sprintf("Use %s[%s]", option.qualified, elem),
+			TextEdits: []analysis.TextEdit{{
+				Pos:     star.Pos(),
+				End:     star.End(),
+				NewText: fmt.Appendf(nil, "%s[%s]", option.qualified, elem),
+			}},
+		}
+		if tag, ok := omitzeroTag(field.Tag); ok {
+			fix.TextEdits = append(fix.TextEdits, analysis.TextEdit{
+				Pos:     field.Tag.Pos(),
+				End:     field.Tag.End(),
+				NewText: []byte(tag),
+			})
+		}
+		if option.missingImport {
+			fix.TextEdits = append(fix.TextEdits, analysis.TextEdit{
+				Pos:     file.Name.End(),
+				End:     file.Name.End(),
+				NewText: []byte("\n\nimport \"" + jagainPath + "\""),
+			})
+		}
+		pass.Report(analysis.Diagnostic{
+			Pos:            field.Pos(),
+			End:            field.End(),
+			Message:        fmt.Sprintf("optional field %s uses a pointer; use %s[%s]", fieldNames(field), option.qualified, elem),
+			SuggestedFixes: []analysis.SuggestedFix{fix},
+		})
+		return true
+	})
+	return nil, nil
+}
+
+// hasExportedName reports whether field declares an exported name. Embedded fields are
+// not reported, since an Option cannot be embedded.
+func hasExportedName(field *ast.Field) bool {
+	return slices.ContainsFunc(field.Names, func(name *ast.Ident) bool { return name.IsExported() })
+}
+
+// fieldNames returns the names declared by field, separated by commas.
+func fieldNames(field *ast.Field) string {
+	names := make([]string, len(field.Names))
+	for i, name := range field.Names {
+		names[i] = name.Name
+	}
+	return strings.Join(names, ", ")
+}
+
+// markedOptional reports whether field has a comment starting with the word "optional"
+// or a json tag with the omitempty option.
+func markedOptional(field *ast.Field) bool {
+	for _, group := range []*ast.CommentGroup{field.Doc, field.Comment} {
+		if group == nil {
+			continue
+		}
+		for _, c := range group.List {
+			text := strings.ToLower(strings.TrimSpace(strings.TrimPrefix(c.Text, "//")))
+			rest, found := strings.CutPrefix(text, "optional")
+			if found && (rest == "" || !unicode.IsLetter([]rune(rest)[0])) {
+				return true
+			}
+		}
+	}
+	if field.Tag == nil {
+		return false
+	}
+	tag, err := strconv.Unquote(field.Tag.Value)
+	if err != nil {
+		return false
+	}
+	_, options, _ := strings.Cut(reflect.StructTag(tag).Get("json"), ",")
+	return slices.Contains(strings.Split(options, ","), "omitempty")
+}
+
+// omitzeroTag returns the source of tag with the json omitempty option replaced by
+// omitzero, and false if it has no omitempty option.
+func omitzeroTag(lit *ast.BasicLit) (string, bool) {
+	if lit == nil {
+		return "", false
+	}
+	tag, err := strconv.Unquote(lit.Value)
+	if err != nil {
+		return "", false
+	}
+	value, ok := reflect.StructTag(tag).Lookup("json")
+	if !ok {
+		return "", false
+	}
+	parts := strings.Split(value, ",")
+	i := slices.Index(parts[1:], "omitempty")
+	if i < 0 {
+		return "", false
+	}
+	if slices.Contains(parts[1:], "omitzero") {
+		parts = slices.Delete(parts, i+1, i+2)
+	} else {
+		parts[i+1] = "omitzero"
+	}
+	tag = strings.Replace(tag, `json:"`+value+`"`, `json:"`+strings.Join(parts, ",")+`"`, 1)
+	if strings.HasPrefix(lit.Value, "`") {
+		return "`" + tag + "`", true
+	}
+	return strconv.Quote(tag), true
+}
+
+// optionRef is how a file refers to jagain.Option.
+type optionRef struct {
+	qualified     string
+	missingImport bool
+}
+
+// optionName returns how file can refer to jagain.Option, noting whether it has to import
+// jagain first.
+func optionName(pass *analysis.Pass, file *ast.File) optionRef {
+	if pass.Pkg.Path() == jagainPath {
+		return optionRef{qualified: "Option"}
+	}
+	for _, imp := range file.Imports {
+		if path, _ := strconv.Unquote(imp.Path.Value); path != jagainPath {
+			continue
+		}
+		switch {
+		case imp.Name == nil:
+			return optionRef{qualified: "jagain.Option"}
+		case imp.Name.Name == ".":
+			return optionRef{qualified: "Option"}
+		case imp.Name.Name != "_":
+			return optionRef{qualified: imp.Name.Name + ".Option"}
+		}
+	}
+	return optionRef{qualified: "jagain.Option", missingImport: true}
+}
+
+// enclosingFile returns the file at the root of stack.
+func enclosingFile(stack []ast.Node) *ast.File {
+	return stack[0].(*ast.File)
+}
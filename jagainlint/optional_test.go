@@ -0,0 +1,11 @@
+package jagainlint
+
+import (
+	"testing"
+
+	"golang.org/x/tools/go/analysis/analysistest"
+)
+
+func TestOptionalAnalyzer(t *testing.T) {
+	analysistest.RunWithSuggestedFixes(t, analysistest.TestData(), OptionalAnalyzer, "optional", "optionalimport")
+}
@@ -0,0 +1,28 @@
+package discard
+
+import "github.com/dendianugerah/jagain"
+
+func save() jagain.Result[int] { return jagain.Ok(1) }
+
+func pair() (jagain.Result[int], error) { return save(), nil }
+
+func plain() int { return 1 }
+
+var _ = save() // want `Result of save is discarded`
+
+func use() {
+	save()                                         // want `Result of save is discarded`
+	_ = save()                                     // want `Result of save is discarded`
+	_, err := pair()                               // want `Result of pair is discarded`
+	go save()                                      // want `Result of save is discarded`
+	defer save()                                   // want `Result of save is discarded`
+	_, _ = plain(), save()                         // want `Result of save is discarded`
+	jagain.Ok(2).Map(func(v int) int { return v }) // want `Result of jagain.Ok\(2\).Map is discarded`
+	_ = err
+
+	r := save()
+	r2, _ := pair()
+	_ = plain()
+	plain()
+	_, _ = r, r2
+}
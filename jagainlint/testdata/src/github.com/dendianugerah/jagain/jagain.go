@@ -0,0 +1,26 @@
+// Package jagain is a stub of the real package for analyzer tests.
+package jagain
+
+type Option[T any] struct {
+	value T
+	valid bool
+}
+
+func Some[T any](v T) Option[T]    { return Option[T]{v, true} }
+func (o Option[T]) IsSome() bool   { return o.valid }
+func (o Option[T]) IsNone() bool   { return !o.valid }
+func (o Option[T]) Unwrap() T      { return o.value }
+func (o Option[T]) UnwrapOr(d T) T { return d }
+
+type Result[T any] struct {
+	value T
+	err   error
+}
+
+func Ok[T any](v T) Result[T]        { return Result[T]{value: v} }
+func (r Result[T]) IsOk() bool       { return r.err == nil }
+func (r Result[T]) IsErr() bool      { return r.err != nil }
+func (r Result[T]) Unwrap() T        { return r.value }
+func (r Result[T]) UnwrapErr() error { return r.err }
+
+func (r Result[T]) Map(f func(T) T) Result[T] { return Ok(f(r.value)) }
@@ -0,0 +1,19 @@
+package optional
+
+import j "github.com/dendianugerah/jagain"
+
+type User struct {
+	ID       int
+	Nickname *string `json:"nickname,omitempty"` // want `optional field Nickname uses a pointer; use j.Option\[string\]`
+	// Optional, defaults to the account's region.
+	Region  *string // want `optional field Region uses a pointer; use j.Option\[string\]`
+	Avatar  *[]byte `json:"avatar,omitempty,omitzero" db:"avatar"` // want `optional field Avatar uses a pointer; use j.Option\[\[\]byte\]`
+	Parent  *User   // optionally set by the loader
+	Manager *User   `json:"manager"`
+	Email   j.Option[string]
+	secret  *string `json:",omitempty"`
+}
+
+var Config struct {
+	Timeout *int // optional // want `optional field Timeout uses a pointer; use j.Option\[int\]`
+}
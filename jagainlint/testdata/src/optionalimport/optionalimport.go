@@ -0,0 +1,6 @@
+package optionalimport
+
+type Filter struct {
+	Limit  *int    `json:"limit,omitempty"`  // want `optional field Limit uses a pointer; use jagain.Option\[int\]`
+	Cursor *string `json:"cursor,omitempty"` // want `optional field Cursor uses a pointer; use jagain.Option\[string\]`
+}
@@ -0,0 +1,94 @@
+package unwrap
+
+import (
+	"errors"
+	"log"
+	"testing"
+
+	"github.com/dendianugerah/jagain"
+)
+
+type user struct {
+	Email jagain.Option[string]
+}
+
+func unguarded(r jagain.Result[int], o jagain.Option[string]) int {
+	_ = o.Unwrap()    // want `Option.Unwrap called without checking IsSome or IsNone first`
+	_ = r.UnwrapErr() // want `Result.UnwrapErr called without checking IsErr or IsOk first`
+	return r.Unwrap() // want `Result.Unwrap called without checking IsOk or IsErr first`
+}
+
+func guardedIf(r jagain.Result[int], o jagain.Option[string]) int {
+	if o.IsSome() {
+		_ = o.Unwrap()
+	}
+	if !o.IsNone() && len(o.Unwrap()) > 0 {
+		_ = o.Unwrap()
+	}
+	if r.IsOk() {
+		return r.Unwrap()
+	} else {
+		_ = r.UnwrapErr()
+	}
+	return 0
+}
+
+func wrongBranch(r jagain.Result[int]) int {
+	if r.IsOk() {
+		_ = r.UnwrapErr() // want `Result.UnwrapErr called without checking IsErr or IsOk first`
+	}
+	if r.IsErr() {
+		return r.Unwrap() // want `Result.Unwrap called without checking IsOk or IsErr first`
+	}
+	return 0
+}
+
+func earlyReturn(r jagain.Result[int]) (int, error) {
+	if r.IsErr() {
+		return 0, r.UnwrapErr()
+	}
+	return r.Unwrap(), nil
+}
+
+func earlyFatal(t *testing.T, r jagain.Result[int]) int {
+	if !r.IsOk() {
+		t.Fatalf("unexpected error: %v", r.UnwrapErr())
+	}
+	return r.Unwrap()
+}
+
+func earlyLogFatal(o jagain.Option[string]) string {
+	if o.IsNone() {
+		log.Fatal("missing")
+	}
+	return o.Unwrap()
+}
+
+func fallThrough(r jagain.Result[int]) int {
+	if r.IsErr() {
+		log.Print("error")
+	}
+	return r.Unwrap() // want `Result.Unwrap called without checking IsOk or IsErr first`
+}
+
+func otherValue(a, b jagain.Result[int]) int {
+	if a.IsOk() {
+		return b.Unwrap() // want `Result.Unwrap called without checking IsOk or IsErr first`
+	}
+	return 0
+}
+
+func fields(u *user) string {
+	if u.Email.IsSome() {
+		return u.Email.Unwrap()
+	}
+	return ""
+}
+
+func savedCheck(r jagain.Result[int]) error {
+	failed := r.IsErr()
+	if failed {
+		return r.UnwrapErr()
+	}
+	return errors.New("ok")
+}
@@ -0,0 +1,216 @@
+// Package jagainlint provides go/analysis analyzers that catch common mistakes with jagain
+// Options and Results and point out code that could use them. The cmd/jagainlint command
+// bundles them for use with go vet:
+//
+//	go install github.com/dendianugerah/jagain/cmd/jagainlint@latest
+//	go vet -vettool=$(which jagainlint) ./...
+package jagainlint
+
+import (
+	"go/token"
+	"go/types"
+
+	"golang.org/x/tools/go/analysis"
+	"golang.org/x/tools/go/analysis/passes/buildssa"
+	"golang.org/x/tools/go/ssa"
+)
+
+const jagainPath = "github.com/dendianugerah/jagain"
+
+// UnwrapAnalyzer reports Unwrap and UnwrapErr calls that are not guarded by a check of
+// the same Option or Result. A call is guarded when every path to it passes a branch on
+// IsSome, IsNone, IsOk or IsErr that rules out the panic, for example:
+//
+//	if r.IsErr() {
+//		return r.UnwrapErr()
+//	}
+//	v := r.Unwrap()
+//
+// Branches that end in panic, os.Exit, log.Fatal or t.Fatal count as leaving the function.
+var UnwrapAnalyzer = &analysis.Analyzer{
+	Name:     "unwrapcheck",
+	Doc:      "report Unwrap and UnwrapErr calls not guarded by IsSome, IsOk or IsErr",
+	Requires: []*analysis.Analyzer{buildssa.Analyzer},
+	Run:      runUnwrap,
+}
+
+// guards lists, for each unsafe method, the checks that make it safe when they are true
+// and those that make it safe when they are false.
+var guards = map[[2]string]struct{ whenTrue, whenFalse string }{
+	{"Option", "Unwrap"}:    {"IsSome", "IsNone"},
+	{"Result", "Unwrap"}:    {"IsOk", "IsErr"},
+	{"Result", "UnwrapErr"}: {"IsErr", "IsOk"},
+}
+
+func runUnwrap(pass *analysis.Pass) (any, error) {
+	if pass.Pkg.Path() == jagainPath {
+		return nil, nil
+	}
+	ssainfo := pass.ResultOf[buildssa.Analyzer].(*buildssa.SSA)
+	for _, fn := range ssainfo.SrcFuncs {
+		for _, b := range fn.Blocks {
+			for _, instr := range b.Instrs {
+				call, ok := instr.(*ssa.Call)
+				if !ok {
+					continue
+				}
+				typ, method, ok := jagainMethod(call.Common())
+				if !ok {
+					continue
+				}
+				g, ok := guards[[2]string{typ, method}]
+				if !ok || isGuarded(b, receiverKey(call.Common().Args[0]), g.whenTrue, g.whenFalse) {
+					continue
+				}
+				pass.Reportf(call.Pos(), "%s.%s called without checking %s or %s first", typ, method, g.whenTrue, g.whenFalse)
+			}
+		}
+	}
+	return nil, nil
+}
+
+// jagainMethod returns the type and method name of a call to a method of a jagain
+// Option or Result.
+func jagainMethod(call *ssa.CallCommon) (typ, method string, ok bool) {
+	fn := callee(call)
+	if fn == nil || fn.Pkg() == nil || fn.Pkg().Path() != jagainPath {
+		return "", "", false
+	}
+	recv := fn.Signature().Recv()
+	if recv == nil {
+		return "", "", false
+	}
+	t := recv.Type()
+	if p, isPtr := t.(*types.Pointer); isPtr {
+		t = p.Elem()
+	}
+	named, isNamed := t.(*types.Named)
+	if !isNamed {
+		return "", "", false
+	}
+	return named.Origin().Obj().Name(), fn.Name(), true
+}
+
+// callee returns the function or method called by call, or nil for dynamic calls.
+func callee(call *ssa.CallCommon) *types.Func {
+	if call.IsInvoke() {
+		return call.Method
+	}
+	if fn := call.StaticCallee(); fn != nil {
+		if obj, ok := fn.Object().(*types.Func); ok {
+			return obj.Origin()
+		}
+	}
+	return nil
+}
+
+// isGuarded reports whether every path to b passes a branch on a check of the receiver
+// identified by key that rules out a panic: the check named whenTrue being true, or the
+// one named whenFalse being false.
+func isGuarded(b *ssa.BasicBlock, key any, whenTrue, whenFalse string) bool {
+	for d := b.Idom(); d != nil; d = d.Idom() {
+		ifInstr, ok := d.Instrs[len(d.Instrs)-1].(*ssa.If)
+		if !ok {
+			continue
+		}
+		positive, ok := checkPolarity(ifInstr.Cond, key, whenTrue, whenFalse)
+		if !ok {
+			continue
+		}
+		bad := d.Succs[1]
+		if !positive {
+			bad = d.Succs[0]
+		}
+		if !reaches(bad, b, d) {
+			return true
+		}
+	}
+	return false
+}
+
+// checkPolarity reports whether cond is a check of the receiver identified by key, and
+// whether it being true rules out a panic.
+func checkPolarity(cond ssa.Value, key any, whenTrue, whenFalse string) (positive, ok bool) {
+	if not, isUnOp := cond.(*ssa.UnOp); isUnOp && not.Op == token.NOT {
+		positive, ok = checkPolarity(not.X, key, whenTrue, whenFalse)
+		return !positive, ok
+	}
+	call, isCall := cond.(*ssa.Call)
+	if !isCall {
+		return false, false
+	}
+	_, method, isMethod := jagainMethod(call.Common())
+	if !isMethod || receiverKey(call.Common().Args[0]) != key {
+		return false, false
+	}
+	switch method {
+	case whenTrue:
+		return true, true
+	case whenFalse:
+		return false, true
+	}
+	return false, false
+}
+
+// reaches reports whether target can be reached from start without going through stop
+// or a block that leaves the function.
+func reaches(start, target, stop *ssa.BasicBlock) bool {
+	seen := map[*ssa.BasicBlock]bool{stop: true}
+	queue := []*ssa.BasicBlock{start}
+	for len(queue) > 0 {
+		b := queue[0]
+		queue = queue[1:]
+		if b == target {
+			return true
+		}
+		if seen[b] || noReturn(b) {
+			continue
+		}
+		seen[b] = true
+		queue = append(queue, b.Succs...)
+	}
+	return false
+}
+
+// noReturn reports whether b calls a function that never returns.
+func noReturn(b *ssa.BasicBlock) bool {
+	for _, instr := range b.Instrs {
+		call, ok := instr.(*ssa.Call)
+		if !ok {
+			continue
+		}
+		fn := callee(call.Common())
+		if fn == nil || fn.Pkg() == nil {
+			continue
+		}
+		switch fn.Pkg().Path() + "." + fn.Name() {
+		case "os.Exit",
+			"log.Fatal", "log.Fatalf", "log.Fatalln", "log.Panic", "log.Panicf", "log.Panicln",
+			"testing.Fatal", "testing.Fatalf", "testing.FailNow", "testing.Skip", "testing.Skipf", "testing.SkipNow":
+			return true
+		}
+	}
+	return false
+}
+
+// fieldKey identifies a field of a value or of the variable a pointer refers to.
+type fieldKey struct {
+	base  any
+	field int
+}
+
+// receiverKey identifies the Option or Result a method is called on, so that loads of the
+// same variable or field compare equal.
+func receiverKey(v ssa.Value) any {
+	switch v := v.(type) {
+	case *ssa.UnOp:
+		if v.Op == token.MUL {
+			return receiverKey(v.X)
+		}
+	case *ssa.FieldAddr:
+		return fieldKey{receiverKey(v.X), v.Field}
+	case *ssa.Field:
+		return fieldKey{receiverKey(v.X), v.Field}
+	}
+	return v
+}
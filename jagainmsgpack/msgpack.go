@@ -0,0 +1,114 @@
+// Package jagainmsgpack encodes jagain Options and Results with vmihailenco/msgpack.
+// None is encoded as nil, an Ok Result as {"ok": value} and an Err Result as
+// {"err": message}.
+//
+// msgpack only finds encoders for types it is told about, so every instantiation used in
+// msgpack payloads is registered once, typically from an init function:
+//
+//	func init() {
+//		jagainmsgpack.RegisterOption[string]()
+//		jagainmsgpack.RegisterResult[int]()
+//	}
+package jagainmsgpack
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+
+	"github.com/dendianugerah/jagain"
+	"github.com/vmihailenco/msgpack/v5"
+	"github.com/vmihailenco/msgpack/v5/msgpcode"
+)
+
+// RegisterOption registers the msgpack encoder and decoder for Option[T].
+// A nil value decodes to None.
+func RegisterOption[T any]() {
+	msgpack.Register(jagain.Option[T]{}, encodeOption[T], decodeOption[T])
+}
+
+// RegisterResult registers the msgpack encoder and decoder for Result[T].
+// A decoded error carries the original message but not the original error type.
+func RegisterResult[T any]() {
+	msgpack.Register(jagain.Result[T]{}, encodeResult[T], decodeResult[T])
+}
+
+func encodeOption[T any](enc *msgpack.Encoder, v reflect.Value) error {
+	o := v.Interface().(jagain.Option[T])
+	if o.IsNone() {
+		return enc.EncodeNil()
+	}
+	return enc.Encode(o.Unwrap())
+}
+
+func decodeOption[T any](dec *msgpack.Decoder, v reflect.Value) error {
+	code, err := dec.PeekCode()
+	if err != nil {
+		return err
+	}
+	if code == msgpcode.Nil {
+		v.Set(reflect.ValueOf(jagain.None[T]()))
+		return dec.DecodeNil()
+	}
+
+	var value T
+	if err := dec.Decode(&value); err != nil {
+		return err
+	}
+	v.Set(reflect.ValueOf(jagain.Some(value)))
+	return nil
+}
+
+func encodeResult[T any](enc *msgpack.Encoder, v reflect.Value) error {
+	r := v.Interface().(jagain.Result[T])
+	if err := enc.EncodeMapLen(1); err != nil {
+		return err
+	}
+	if r.IsOk() {
+		if err := enc.EncodeString("ok"); err != nil {
+			return err
+		}
+		return enc.Encode(r.Unwrap())
+	}
+
+	if err := enc.EncodeString("err"); err != nil {
+		return err
+	}
+	var msg string
+	if err := r.UnwrapErr(); err != nil {
+		msg = err.Error()
+	}
+	return enc.EncodeString(msg)
+}
+
+func decodeResult[T any](dec *msgpack.Decoder, v reflect.Value) error {
+	n, err := dec.DecodeMapLen()
+	if err != nil {
+		return err
+	}
+	if n != 1 {
+		return fmt.Errorf("msgpack: expected a result map with 1 entry, got %d", n)
+	}
+
+	key, err := dec.DecodeString()
+	if err != nil {
+		return err
+	}
+	switch key {
+	case "ok":
+		var value T
+		if err := dec.Decode(&value); err != nil {
+			return err
+		}
+		v.Set(reflect.ValueOf(jagain.Ok(value)))
+	case "err":
+		msg, err := dec.DecodeString()
+		if err != nil {
+			return err
+		}
+		v.Set(reflect.ValueOf(jagain.Err[T](errors.New(msg))))
+	default:
+		return fmt.Errorf("msgpack: unexpected result key %q", key)
+	}
+	return nil
+}
@@ -0,0 +1,73 @@
+package jagainmsgpack
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/dendianugerah/jagain"
+	"github.com/vmihailenco/msgpack/v5"
+)
+
+type msgpackRecord struct {
+	Name   string
+	Email  jagain.Option[string]
+	Age    jagain.Option[int]
+	Lookup jagain.Result[int]
+	Failed jagain.Result[string]
+}
+
+func init() {
+	RegisterOption[string]()
+	RegisterOption[int]()
+	RegisterResult[int]()
+	RegisterResult[string]()
+}
+
+func TestMsgpack(t *testing.T) {
+	// Test None is encoded as nil
+	data, err := msgpack.Marshal(jagain.None[int]())
+	if err != nil {
+		t.Fatalf("Failed to marshal None: %v", err)
+	}
+	var raw any
+	if err := msgpack.Unmarshal(data, &raw); err != nil || raw != nil {
+		t.Errorf("Expected None to be encoded as nil, got %v (%v)", raw, err)
+	}
+
+	// Test round trip of a struct
+	record := msgpackRecord{
+		Name:   "Jane",
+		Email:  jagain.Some("jane@example.com"),
+		Age:    jagain.None[int](),
+		Lookup: jagain.Ok(3),
+		Failed: jagain.Err[string](errors.New("boom")),
+	}
+	data, err = msgpack.Marshal(record)
+	if err != nil {
+		t.Fatalf("Failed to marshal record: %v", err)
+	}
+
+	var decoded msgpackRecord
+	if err := msgpack.Unmarshal(data, &decoded); err != nil {
+		t.Fatalf("Failed to unmarshal record: %v", err)
+	}
+	if decoded.Email.UnwrapOr("") != "jane@example.com" {
+		t.Errorf("Expected Email to survive msgpack, got %v", decoded.Email)
+	}
+	if !decoded.Age.IsNone() {
+		t.Errorf("Expected Age to be None, got %v", decoded.Age)
+	}
+	if decoded.Lookup.UnwrapOr(0) != 3 {
+		t.Errorf("Expected Lookup to be Ok(3), got %v", decoded.Lookup)
+	}
+	if !decoded.Failed.IsErr() || decoded.Failed.UnwrapErr().Error() != "boom" {
+		t.Errorf("Expected Failed to be Err(boom), got %v", decoded.Failed)
+	}
+
+	// Test decoding a malformed result
+	data, _ = msgpack.Marshal(map[string]int{"maybe": 1})
+	var bad jagain.Result[int]
+	if err := msgpack.Unmarshal(data, &bad); err == nil {
+		t.Errorf("Expected unknown result key to fail")
+	}
+}
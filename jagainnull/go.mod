@@ -0,0 +1,11 @@
+module github.com/dendianugerah/jagain/jagainnull
+
+go 1.23
+
+require (
+	github.com/dendianugerah/jagain v0.0.0-00010101000000-000000000000
+	github.com/guregu/null/v6 v6.0.0
+	github.com/volatiletech/null/v9 v9.0.0
+)
+
+replace github.com/dendianugerah/jagain => ..
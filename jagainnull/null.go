@@ -0,0 +1,102 @@
+// Package jagainnull converts between jagain Options and the nullable types of
+// github.com/guregu/null, so code built on them can move to Options gradually. The
+// types of github.com/volatiletech/null, which sqlboiler uses, are covered by the
+// volatilenull subpackage.
+package jagainnull
+
+import (
+	"time"
+
+	"github.com/dendianugerah/jagain"
+	"github.com/guregu/null/v6"
+)
+
+// FromString converts a null.String to an Option.
+func FromString(n null.String) jagain.Option[string] {
+	return jagain.FromNullString(n.NullString)
+}
+
+// ToString converts an Option to a null.String.
+func ToString(o jagain.Option[string]) null.String {
+	return null.String{NullString: jagain.ToNullString(o)}
+}
+
+// FromInt converts a null.Int to an Option.
+func FromInt(n null.Int) jagain.Option[int64] {
+	return jagain.FromNullInt64(n.NullInt64)
+}
+
+// ToInt converts an Option to a null.Int.
+func ToInt(o jagain.Option[int64]) null.Int {
+	return null.Int{NullInt64: jagain.ToNullInt64(o)}
+}
+
+// FromInt32 converts a null.Int32 to an Option.
+func FromInt32(n null.Int32) jagain.Option[int32] {
+	return jagain.FromNullInt32(n.NullInt32)
+}
+
+// ToInt32 converts an Option to a null.Int32.
+func ToInt32(o jagain.Option[int32]) null.Int32 {
+	return null.Int32{NullInt32: jagain.ToNullInt32(o)}
+}
+
+// FromInt16 converts a null.Int16 to an Option.
+func FromInt16(n null.Int16) jagain.Option[int16] {
+	return jagain.FromNullInt16(n.NullInt16)
+}
+
+// ToInt16 converts an Option to a null.Int16.
+func ToInt16(o jagain.Option[int16]) null.Int16 {
+	return null.Int16{NullInt16: jagain.ToNullInt16(o)}
+}
+
+// FromByte converts a null.Byte to an Option.
+func FromByte(n null.Byte) jagain.Option[byte] {
+	return jagain.FromNullByte(n.NullByte)
+}
+
+// ToByte converts an Option to a null.Byte.
+func ToByte(o jagain.Option[byte]) null.Byte {
+	return null.Byte{NullByte: jagain.ToNullByte(o)}
+}
+
+// FromFloat converts a null.Float to an Option.
+func FromFloat(n null.Float) jagain.Option[float64] {
+	return jagain.FromNullFloat64(n.NullFloat64)
+}
+
+// ToFloat converts an Option to a null.Float.
+func ToFloat(o jagain.Option[float64]) null.Float {
+	return null.Float{NullFloat64: jagain.ToNullFloat64(o)}
+}
+
+// FromBool converts a null.Bool to an Option.
+func FromBool(n null.Bool) jagain.Option[bool] {
+	return jagain.FromNullBool(n.NullBool)
+}
+
+// ToBool converts an Option to a null.Bool.
+func ToBool(o jagain.Option[bool]) null.Bool {
+	return null.Bool{NullBool: jagain.ToNullBool(o)}
+}
+
+// FromTime converts a null.Time to an Option.
+func FromTime(n null.Time) jagain.Option[time.Time] {
+	return jagain.FromNullTime(n.NullTime)
+}
+
+// ToTime converts an Option to a null.Time.
+func ToTime(o jagain.Option[time.Time]) null.Time {
+	return null.Time{NullTime: jagain.ToNullTime(o)}
+}
+
+// FromValue converts a null.Value to an Option.
+func FromValue[T any](n null.Value[T]) jagain.Option[T] {
+	return jagain.FromSQLNull(n.Null)
+}
+
+// ToValue converts an Option to a null.Value.
+func ToValue[T any](o jagain.Option[T]) null.Value[T] {
+	return null.Value[T]{Null: jagain.ToSQLNull(o)}
+}
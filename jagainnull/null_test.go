@@ -0,0 +1,62 @@
+package jagainnull
+
+import (
+	"testing"
+	"time"
+
+	"github.com/dendianugerah/jagain"
+	"github.com/guregu/null/v6"
+)
+
+func TestString(t *testing.T) {
+	// Test FromString
+	if o := FromString(null.StringFrom("a")); o.UnwrapOr("") != "a" {
+		t.Errorf("Expected Some(a), got %v", o)
+	}
+	if o := FromString(null.String{}); o.IsSome() {
+		t.Errorf("Expected None, got %v", o)
+	}
+
+	// Test ToString
+	if n := ToString(jagain.Some("")); !n.Valid || n.String != "" {
+		t.Errorf("Expected a valid empty string, got %v", n)
+	}
+	if n := ToString(jagain.None[string]()); n.Valid {
+		t.Errorf("Expected null, got %v", n)
+	}
+}
+
+func TestInt(t *testing.T) {
+	if o := FromInt(null.IntFrom(42)); o.UnwrapOr(0) != 42 {
+		t.Errorf("Expected Some(42), got %v", o)
+	}
+	if n := ToInt(jagain.Some[int64](42)); !n.Valid || n.Int64 != 42 {
+		t.Errorf("Expected 42, got %v", n)
+	}
+	if n := ToInt32(jagain.None[int32]()); n.Valid {
+		t.Errorf("Expected null, got %v", n)
+	}
+}
+
+func TestTime(t *testing.T) {
+	now := time.Now()
+	if o := FromTime(null.TimeFrom(now)); !o.UnwrapOr(time.Time{}).Equal(now) {
+		t.Errorf("Expected Some(%v), got %v", now, o)
+	}
+	if n := ToTime(jagain.None[time.Time]()); n.Valid {
+		t.Errorf("Expected null, got %v", n)
+	}
+}
+
+func TestValue(t *testing.T) {
+	type status string
+	if o := FromValue(null.ValueFrom(status("active"))); o.UnwrapOr("") != "active" {
+		t.Errorf("Expected Some(active), got %v", o)
+	}
+	if n := ToValue(jagain.Some(status("active"))); !n.Valid || n.V != "active" {
+		t.Errorf("Expected active, got %v", n)
+	}
+	if o := FromValue(null.Value[status]{}); o.IsSome() {
+		t.Errorf("Expected None, got %v", o)
+	}
+}
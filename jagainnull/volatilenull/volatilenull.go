@@ -0,0 +1,196 @@
+// Package volatilenull converts between jagain Options and the nullable types of
+// github.com/volatiletech/null, which sqlboiler generates, so code built on them can move
+// to Options gradually. The Set flag that null types use to track JSON presence is not
+// kept: converting to null always produces a set value. null.JSON has no counterpart.
+package volatilenull
+
+import (
+	"time"
+
+	"github.com/dendianugerah/jagain"
+	"github.com/volatiletech/null/v9"
+)
+
+// FromString converts a null.String to an Option.
+func FromString(n null.String) jagain.Option[string] {
+	return option(n.String, n.Valid)
+}
+
+// ToString converts an Option to a null.String.
+func ToString(o jagain.Option[string]) null.String {
+	return null.NewString(value(o))
+}
+
+// FromInt converts a null.Int to an Option.
+func FromInt(n null.Int) jagain.Option[int] {
+	return option(n.Int, n.Valid)
+}
+
+// ToInt converts an Option to a null.Int.
+func ToInt(o jagain.Option[int]) null.Int {
+	return null.NewInt(value(o))
+}
+
+// FromInt8 converts a null.Int8 to an Option.
+func FromInt8(n null.Int8) jagain.Option[int8] {
+	return option(n.Int8, n.Valid)
+}
+
+// ToInt8 converts an Option to a null.Int8.
+func ToInt8(o jagain.Option[int8]) null.Int8 {
+	return null.NewInt8(value(o))
+}
+
+// FromInt16 converts a null.Int16 to an Option.
+func FromInt16(n null.Int16) jagain.Option[int16] {
+	return option(n.Int16, n.Valid)
+}
+
+// ToInt16 converts an Option to a null.Int16.
+func ToInt16(o jagain.Option[int16]) null.Int16 {
+	return null.NewInt16(value(o))
+}
+
+// FromInt32 converts a null.Int32 to an Option.
+func FromInt32(n null.Int32) jagain.Option[int32] {
+	return option(n.Int32, n.Valid)
+}
+
+// ToInt32 converts an Option to a null.Int32.
+func ToInt32(o jagain.Option[int32]) null.Int32 {
+	return null.NewInt32(value(o))
+}
+
+// FromInt64 converts a null.Int64 to an Option.
+func FromInt64(n null.Int64) jagain.Option[int64] {
+	return option(n.Int64, n.Valid)
+}
+
+// ToInt64 converts an Option to a null.Int64.
+func ToInt64(o jagain.Option[int64]) null.Int64 {
+	return null.NewInt64(value(o))
+}
+
+// FromUint converts a null.Uint to an Option.
+func FromUint(n null.Uint) jagain.Option[uint] {
+	return option(n.Uint, n.Valid)
+}
+
+// ToUint converts an Option to a null.Uint.
+func ToUint(o jagain.Option[uint]) null.Uint {
+	return null.NewUint(value(o))
+}
+
+// FromUint8 converts a null.Uint8 to an Option.
+func FromUint8(n null.Uint8) jagain.Option[uint8] {
+	return option(n.Uint8, n.Valid)
+}
+
+// ToUint8 converts an Option to a null.Uint8.
+func ToUint8(o jagain.Option[uint8]) null.Uint8 {
+	return null.NewUint8(value(o))
+}
+
+// FromUint16 converts a null.Uint16 to an Option.
+func FromUint16(n null.Uint16) jagain.Option[uint16] {
+	return option(n.Uint16, n.Valid)
+}
+
+// ToUint16 converts an Option to a null.Uint16.
+func ToUint16(o jagain.Option[uint16]) null.Uint16 {
+	return null.NewUint16(value(o))
+}
+
+// FromUint32 converts a null.Uint32 to an Option.
+func FromUint32(n null.Uint32) jagain.Option[uint32] {
+	return option(n.Uint32, n.Valid)
+}
+
+// ToUint32 converts an Option to a null.Uint32.
+func ToUint32(o jagain.Option[uint32]) null.Uint32 {
+	return null.NewUint32(value(o))
+}
+
+// FromUint64 converts a null.Uint64 to an Option.
+func FromUint64(n null.Uint64) jagain.Option[uint64] {
+	return option(n.Uint64, n.Valid)
+}
+
+// ToUint64 converts an Option to a null.Uint64.
+func ToUint64(o jagain.Option[uint64]) null.Uint64 {
+	return null.NewUint64(value(o))
+}
+
+// FromFloat32 converts a null.Float32 to an Option.
+func FromFloat32(n null.Float32) jagain.Option[float32] {
+	return option(n.Float32, n.Valid)
+}
+
+// ToFloat32 converts an Option to a null.Float32.
+func ToFloat32(o jagain.Option[float32]) null.Float32 {
+	return null.NewFloat32(value(o))
+}
+
+// FromFloat64 converts a null.Float64 to an Option.
+func FromFloat64(n null.Float64) jagain.Option[float64] {
+	return option(n.Float64, n.Valid)
+}
+
+// ToFloat64 converts an Option to a null.Float64.
+func ToFloat64(o jagain.Option[float64]) null.Float64 {
+	return null.NewFloat64(value(o))
+}
+
+// FromBool converts a null.Bool to an Option.
+func FromBool(n null.Bool) jagain.Option[bool] {
+	return option(n.Bool, n.Valid)
+}
+
+// ToBool converts an Option to a null.Bool.
+func ToBool(o jagain.Option[bool]) null.Bool {
+	return null.NewBool(value(o))
+}
+
+// FromByte converts a null.Byte to an Option.
+func FromByte(n null.Byte) jagain.Option[byte] {
+	return option(n.Byte, n.Valid)
+}
+
+// ToByte converts an Option to a null.Byte.
+func ToByte(o jagain.Option[byte]) null.Byte {
+	return null.NewByte(value(o))
+}
+
+// FromBytes converts a null.Bytes to an Option.
+func FromBytes(n null.Bytes) jagain.Option[[]byte] {
+	return option(n.Bytes, n.Valid)
+}
+
+// ToBytes converts an Option to a null.Bytes.
+func ToBytes(o jagain.Option[[]byte]) null.Bytes {
+	return null.NewBytes(value(o))
+}
+
+// FromTime converts a null.Time to an Option.
+func FromTime(n null.Time) jagain.Option[time.Time] {
+	return option(n.Time, n.Valid)
+}
+
+// ToTime converts an Option to a null.Time.
+func ToTime(o jagain.Option[time.Time]) null.Time {
+	return null.NewTime(value(o))
+}
+
+// option returns Some(v) if valid is set and None otherwise.
+func option[T any](v T, valid bool) jagain.Option[T] {
+	if !valid {
+		return jagain.None[T]()
+	}
+	return jagain.Some(v)
+}
+
+// value returns the value of o, or the zero value for None, and whether o is Some.
+func value[T any](o jagain.Option[T]) (T, bool) {
+	var zero T
+	return o.UnwrapOr(zero), o.IsSome()
+}
@@ -0,0 +1,46 @@
+// Package jagainotel records jagain Results on OpenTelemetry spans.
+package jagainotel
+
+import (
+	"context"
+
+	"github.com/dendianugerah/jagain"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// TracerName is the instrumentation name of the tracer used by Traced.
+const TracerName = "github.com/dendianugerah/jagain/jagainotel"
+
+// ErrorCodeKey is the span attribute holding the code of an Err result.
+const ErrorCodeKey = attribute.Key("error.code")
+
+// RecordResult records r on span and returns r unchanged. An Err is recorded as an error
+// event, sets the span status to Error with the error message, and adds the error code
+// attribute when the error carries a code. Ok results leave the span untouched.
+func RecordResult[T any](span trace.Span, r jagain.Result[T]) jagain.Result[T] {
+	if r.IsOk() {
+		return r
+	}
+	err := r.UnwrapErr()
+	if err == nil {
+		span.SetStatus(codes.Error, "")
+		return r
+	}
+	span.RecordError(err)
+	span.SetStatus(codes.Error, err.Error())
+	if code := jagain.ErrorCode(err); code.IsSome() {
+		span.SetAttributes(ErrorCodeKey.String(code.Unwrap()))
+	}
+	return r
+}
+
+// Traced runs f inside a new span called name, started from ctx with the global tracer
+// provider, and records the Result on the span before ending it.
+func Traced[T any](ctx context.Context, name string, f func(context.Context) jagain.Result[T], opts ...trace.SpanStartOption) jagain.Result[T] {
+	ctx, span := otel.Tracer(TracerName).Start(ctx, name, opts...)
+	defer span.End()
+	return RecordResult(span, f(ctx))
+}
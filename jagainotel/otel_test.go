@@ -0,0 +1,62 @@
+package jagainotel
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/dendianugerah/jagain"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/codes"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	"go.opentelemetry.io/otel/trace"
+)
+
+func TestTraced(t *testing.T) {
+	exporter := tracetest.NewInMemoryExporter()
+	provider := sdktrace.NewTracerProvider(sdktrace.WithSyncer(exporter))
+	prev := otel.GetTracerProvider()
+	otel.SetTracerProvider(provider)
+	defer otel.SetTracerProvider(prev)
+
+	// Test an Ok result
+	r := Traced(context.Background(), "load", func(ctx context.Context) jagain.Result[int] {
+		if !trace.SpanFromContext(ctx).SpanContext().IsValid() {
+			t.Errorf("Expected f to receive the span context")
+		}
+		return jagain.Ok(1)
+	})
+	if r.UnwrapOr(0) != 1 {
+		t.Errorf("Expected Ok(1), got %v", r)
+	}
+
+	// Test an Err result with a code
+	Traced(context.Background(), "save", func(context.Context) jagain.Result[int] {
+		return jagain.ErrCode[int]("CONFLICT", errors.New("version mismatch"))
+	})
+
+	spans := exporter.GetSpans()
+	if len(spans) != 2 {
+		t.Fatalf("Expected 2 spans, got %d", len(spans))
+	}
+	if spans[0].Name != "load" || spans[0].Status.Code != codes.Unset {
+		t.Errorf("Expected load span without error status, got %v", spans[0].Status)
+	}
+	save := spans[1]
+	if save.Status.Code != codes.Error || save.Status.Description != "version mismatch" {
+		t.Errorf("Expected error status, got %v", save.Status)
+	}
+	if len(save.Events) != 1 || save.Events[0].Name != "exception" {
+		t.Errorf("Expected an exception event, got %v", save.Events)
+	}
+	found := false
+	for _, attr := range save.Attributes {
+		if attr.Key == ErrorCodeKey && attr.Value.AsString() == "CONFLICT" {
+			found = true
+		}
+	}
+	if !found {
+		t.Errorf("Expected error code attribute, got %v", save.Attributes)
+	}
+}
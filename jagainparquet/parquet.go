@@ -0,0 +1,62 @@
+// Package jagainparquet reads and writes jagain Options with
+// github.com/parquet-go/parquet-go, which cannot see inside Options on its own.
+// Option[T] fields, including those of nested structs, are OPTIONAL columns of T.
+package jagainparquet
+
+import (
+	"errors"
+	"io"
+	"reflect"
+
+	"github.com/dendianugerah/jagain"
+	"github.com/dendianugerah/jagain/internal/pointers"
+	"github.com/parquet-go/parquet-go"
+)
+
+// Schema returns the parquet schema of the struct type T, in which Option[T] fields are
+// OPTIONAL columns of T. Struct tags are interpreted as by parquet.SchemaOf.
+func Schema[T any]() *parquet.Schema {
+	return parquet.SchemaOf(reflect.New(pointers.Type(reflect.TypeFor[T]())).Interface())
+}
+
+// Write writes rows to w as a parquet file with the schema Schema[T] returns,
+// writing None as null.
+func Write[T any](w io.Writer, rows []T, opts ...parquet.WriterOption) error {
+	t := pointers.Type(reflect.TypeFor[T]())
+	pw := parquet.NewWriter(w, append([]parquet.WriterOption{Schema[T]()}, opts...)...)
+	for _, row := range rows {
+		if err := pw.Write(pointers.To(reflect.ValueOf(row), t).Interface()); err != nil {
+			return err
+		}
+	}
+	return pw.Close()
+}
+
+// Read reads every row of the parquet file in r, whose size is size, into T.
+// Null values of OPTIONAL columns read as None.
+func Read[T any](r io.ReaderAt, size int64, opts ...parquet.ReaderOption) jagain.Result[[]T] {
+	file, err := parquet.OpenFile(r, size)
+	if err != nil {
+		return jagain.Err[[]T](err)
+	}
+
+	t := pointers.Type(reflect.TypeFor[T]())
+	pr := parquet.NewReader(file, opts...)
+	defer pr.Close()
+	rows := make([]T, 0, file.NumRows())
+	for {
+		row := reflect.New(t)
+		err := pr.Read(row.Interface())
+		if errors.Is(err, io.EOF) {
+			return jagain.Ok(rows)
+		}
+		if err != nil {
+			return jagain.Err[[]T](err)
+		}
+		var value T
+		if err := pointers.From(row.Elem(), reflect.ValueOf(&value).Elem()); err != nil {
+			return jagain.Err[[]T](err)
+		}
+		rows = append(rows, value)
+	}
+}
@@ -0,0 +1,78 @@
+package jagainparquet
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/dendianugerah/jagain"
+	"github.com/parquet-go/parquet-go"
+)
+
+type parquetDimensions struct {
+	Width  float64                `parquet:"width"`
+	Height jagain.Option[float64] `parquet:"height"`
+}
+
+type parquetProduct struct {
+	SKU     string                   `parquet:"sku"`
+	Name    jagain.Option[string]    `parquet:"name"`
+	Stock   jagain.Option[int64]     `parquet:"stock"`
+	Updated jagain.Option[time.Time] `parquet:"updated,timestamp"`
+	Size    parquetDimensions        `parquet:"size"`
+}
+
+func TestParquet(t *testing.T) {
+	updated := time.Date(2024, 5, 1, 12, 0, 0, 0, time.UTC)
+	products := []parquetProduct{
+		{SKU: "a1", Name: jagain.Some("Lamp"), Stock: jagain.Some[int64](3), Updated: jagain.Some(updated), Size: parquetDimensions{1, jagain.Some(2.5)}},
+		{SKU: "b2", Name: jagain.None[string](), Stock: jagain.Some[int64](0), Updated: jagain.None[time.Time](), Size: parquetDimensions{3, jagain.None[float64]()}},
+	}
+
+	// Test Options become optional columns
+	schema := Schema[parquetProduct]().String()
+	for _, col := range []string{"optional binary name", "optional int64 stock", "optional double height", "required binary sku"} {
+		if !strings.Contains(schema, col) {
+			t.Errorf("Expected schema to contain %q, got\n%s", col, schema)
+		}
+	}
+
+	// Test round trip
+	var buf bytes.Buffer
+	if err := Write(&buf, products); err != nil {
+		t.Fatalf("Failed to write products: %v", err)
+	}
+	r := Read[parquetProduct](bytes.NewReader(buf.Bytes()), int64(buf.Len()))
+	if r.IsErr() {
+		t.Fatalf("Failed to read products: %v", r.UnwrapErr())
+	}
+	got := r.Unwrap()
+	if len(got) != 2 {
+		t.Fatalf("Expected 2 products, got %d", len(got))
+	}
+	if got[0].Name.UnwrapOr("") != "Lamp" || !got[0].Updated.UnwrapOr(time.Time{}).Equal(updated) || got[0].Size.Height.UnwrapOr(0) != 2.5 {
+		t.Errorf("Expected the first product to round trip, got %+v", got[0])
+	}
+	if got[1].Name.IsSome() || got[1].Updated.IsSome() || got[1].Size.Height.IsSome() {
+		t.Errorf("Expected None values to read back as None, got %+v", got[1])
+	}
+	if got[1].Stock.UnwrapOr(-1) != 0 {
+		t.Errorf("Expected jagain.Some(0) to stay Some, got %v", got[1].Stock)
+	}
+
+	// Test the file is readable with pointer fields
+	type plainProduct struct {
+		SKU  string  `parquet:"sku"`
+		Name *string `parquet:"name"`
+	}
+	plain, err := parquet.Read[plainProduct](bytes.NewReader(buf.Bytes()), int64(buf.Len()))
+	if err != nil || *plain[0].Name != "Lamp" || plain[1].Name != nil {
+		t.Errorf("Expected optional columns to read into pointers, got %+v (%v)", plain, err)
+	}
+
+	// Test invalid input
+	if r := Read[parquetProduct](strings.NewReader("nope"), 4); r.IsOk() {
+		t.Errorf("Expected an error for invalid data")
+	}
+}
@@ -0,0 +1,12 @@
+module github.com/dendianugerah/jagain/jagainpb
+
+go 1.23
+
+require (
+	github.com/dendianugerah/jagain v0.0.0-00010101000000-000000000000
+	google.golang.org/protobuf v1.36.12
+)
+
+require github.com/google/go-cmp v0.7.0 // indirect
+
+replace github.com/dendianugerah/jagain => ..
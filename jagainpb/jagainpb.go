@@ -0,0 +1,201 @@
+// Package jagainpb converts between jagain Options and protobuf optional fields.
+// It covers proto3 optional scalars, which generated code exposes as pointers,
+// and the well-known wrapper and timestamp types.
+package jagainpb
+
+import (
+	"time"
+
+	"github.com/dendianugerah/jagain"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+)
+
+// FromProtoPtr converts a proto3 optional scalar field to an Option.
+// A nil pointer, meaning the field is unset, becomes None.
+func FromProtoPtr[T any](ptr *T) jagain.Option[T] {
+	return jagain.FromPtr(ptr)
+}
+
+// ToProtoPtr converts an Option to a proto3 optional scalar field.
+// None becomes nil, leaving the field unset.
+func ToProtoPtr[T any](o jagain.Option[T]) *T {
+	return o.ToPtr()
+}
+
+// FromStringValue converts a wrapperspb.StringValue to an Option.
+func FromStringValue(v *wrapperspb.StringValue) jagain.Option[string] {
+	if v == nil {
+		return jagain.None[string]()
+	}
+	return jagain.Some(v.GetValue())
+}
+
+// ToStringValue converts an Option to a wrapperspb.StringValue.
+func ToStringValue(o jagain.Option[string]) *wrapperspb.StringValue {
+	if o.IsNone() {
+		return nil
+	}
+	return wrapperspb.String(o.Unwrap())
+}
+
+// FromInt64Value converts a wrapperspb.Int64Value to an Option.
+func FromInt64Value(v *wrapperspb.Int64Value) jagain.Option[int64] {
+	if v == nil {
+		return jagain.None[int64]()
+	}
+	return jagain.Some(v.GetValue())
+}
+
+// ToInt64Value converts an Option to a wrapperspb.Int64Value.
+func ToInt64Value(o jagain.Option[int64]) *wrapperspb.Int64Value {
+	if o.IsNone() {
+		return nil
+	}
+	return wrapperspb.Int64(o.Unwrap())
+}
+
+// FromInt32Value converts a wrapperspb.Int32Value to an Option.
+func FromInt32Value(v *wrapperspb.Int32Value) jagain.Option[int32] {
+	if v == nil {
+		return jagain.None[int32]()
+	}
+	return jagain.Some(v.GetValue())
+}
+
+// ToInt32Value converts an Option to a wrapperspb.Int32Value.
+func ToInt32Value(o jagain.Option[int32]) *wrapperspb.Int32Value {
+	if o.IsNone() {
+		return nil
+	}
+	return wrapperspb.Int32(o.Unwrap())
+}
+
+// FromUInt64Value converts a wrapperspb.UInt64Value to an Option.
+func FromUInt64Value(v *wrapperspb.UInt64Value) jagain.Option[uint64] {
+	if v == nil {
+		return jagain.None[uint64]()
+	}
+	return jagain.Some(v.GetValue())
+}
+
+// ToUInt64Value converts an Option to a wrapperspb.UInt64Value.
+func ToUInt64Value(o jagain.Option[uint64]) *wrapperspb.UInt64Value {
+	if o.IsNone() {
+		return nil
+	}
+	return wrapperspb.UInt64(o.Unwrap())
+}
+
+// FromUInt32Value converts a wrapperspb.UInt32Value to an Option.
+func FromUInt32Value(v *wrapperspb.UInt32Value) jagain.Option[uint32] {
+	if v == nil {
+		return jagain.None[uint32]()
+	}
+	return jagain.Some(v.GetValue())
+}
+
+// ToUInt32Value converts an Option to a wrapperspb.UInt32Value.
+func ToUInt32Value(o jagain.Option[uint32]) *wrapperspb.UInt32Value {
+	if o.IsNone() {
+		return nil
+	}
+	return wrapperspb.UInt32(o.Unwrap())
+}
+
+// FromDoubleValue converts a wrapperspb.DoubleValue to an Option.
+func FromDoubleValue(v *wrapperspb.DoubleValue) jagain.Option[float64] {
+	if v == nil {
+		return jagain.None[float64]()
+	}
+	return jagain.Some(v.GetValue())
+}
+
+// ToDoubleValue converts an Option to a wrapperspb.DoubleValue.
+func ToDoubleValue(o jagain.Option[float64]) *wrapperspb.DoubleValue {
+	if o.IsNone() {
+		return nil
+	}
+	return wrapperspb.Double(o.Unwrap())
+}
+
+// FromFloatValue converts a wrapperspb.FloatValue to an Option.
+func FromFloatValue(v *wrapperspb.FloatValue) jagain.Option[float32] {
+	if v == nil {
+		return jagain.None[float32]()
+	}
+	return jagain.Some(v.GetValue())
+}
+
+// ToFloatValue converts an Option to a wrapperspb.FloatValue.
+func ToFloatValue(o jagain.Option[float32]) *wrapperspb.FloatValue {
+	if o.IsNone() {
+		return nil
+	}
+	return wrapperspb.Float(o.Unwrap())
+}
+
+// FromBoolValue converts a wrapperspb.BoolValue to an Option.
+func FromBoolValue(v *wrapperspb.BoolValue) jagain.Option[bool] {
+	if v == nil {
+		return jagain.None[bool]()
+	}
+	return jagain.Some(v.GetValue())
+}
+
+// ToBoolValue converts an Option to a wrapperspb.BoolValue.
+func ToBoolValue(o jagain.Option[bool]) *wrapperspb.BoolValue {
+	if o.IsNone() {
+		return nil
+	}
+	return wrapperspb.Bool(o.Unwrap())
+}
+
+// FromBytesValue converts a wrapperspb.BytesValue to an Option.
+func FromBytesValue(v *wrapperspb.BytesValue) jagain.Option[[]byte] {
+	if v == nil {
+		return jagain.None[[]byte]()
+	}
+	return jagain.Some(v.GetValue())
+}
+
+// ToBytesValue converts an Option to a wrapperspb.BytesValue.
+func ToBytesValue(o jagain.Option[[]byte]) *wrapperspb.BytesValue {
+	if o.IsNone() {
+		return nil
+	}
+	return wrapperspb.Bytes(o.Unwrap())
+}
+
+// FromTimestamp converts a timestamppb.Timestamp to an Option.
+func FromTimestamp(ts *timestamppb.Timestamp) jagain.Option[time.Time] {
+	if ts == nil {
+		return jagain.None[time.Time]()
+	}
+	return jagain.Some(ts.AsTime())
+}
+
+// ToTimestamp converts an Option to a timestamppb.Timestamp.
+func ToTimestamp(o jagain.Option[time.Time]) *timestamppb.Timestamp {
+	if o.IsNone() {
+		return nil
+	}
+	return timestamppb.New(o.Unwrap())
+}
+
+// FromDuration converts a durationpb.Duration to an Option.
+func FromDuration(d *durationpb.Duration) jagain.Option[time.Duration] {
+	if d == nil {
+		return jagain.None[time.Duration]()
+	}
+	return jagain.Some(d.AsDuration())
+}
+
+// ToDuration converts an Option to a durationpb.Duration.
+func ToDuration(o jagain.Option[time.Duration]) *durationpb.Duration {
+	if o.IsNone() {
+		return nil
+	}
+	return durationpb.New(o.Unwrap())
+}
@@ -0,0 +1,75 @@
+package jagainpb
+
+import (
+	"testing"
+	"time"
+
+	"github.com/dendianugerah/jagain"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+)
+
+func TestProtoPtr(t *testing.T) {
+	// Test FromProtoPtr
+	if FromProtoPtr[int32](nil).IsSome() {
+		t.Errorf("Expected unset optional field to be None")
+	}
+	v := int32(4)
+	if o := FromProtoPtr(&v); o.UnwrapOr(0) != 4 {
+		t.Errorf("Expected set optional field to be Some(4), got %v", o)
+	}
+
+	// Test ToProtoPtr
+	if ToProtoPtr(jagain.None[string]()) != nil {
+		t.Errorf("Expected None to leave the field unset")
+	}
+	if p := ToProtoPtr(jagain.Some("x")); p == nil || *p != "x" {
+		t.Errorf("Expected Some(\"x\") to set the field")
+	}
+}
+
+func TestWrappers(t *testing.T) {
+	// Test wrapper to Option
+	if FromStringValue(nil).IsSome() {
+		t.Errorf("Expected nil StringValue to be None")
+	}
+	if o := FromStringValue(wrapperspb.String("a")); o.UnwrapOr("") != "a" {
+		t.Errorf("Expected StringValue to be Some(\"a\"), got %v", o)
+	}
+	if o := FromInt64Value(wrapperspb.Int64(0)); !o.IsSome() || o.Unwrap() != 0 {
+		t.Errorf("Expected Int64Value(0) to be Some(0), got %v", o)
+	}
+	if o := FromBoolValue(wrapperspb.Bool(true)); !o.UnwrapOr(false) {
+		t.Errorf("Expected BoolValue(true) to be Some(true), got %v", o)
+	}
+
+	// Test Option to wrapper
+	if ToInt64Value(jagain.None[int64]()) != nil {
+		t.Errorf("Expected None to convert to a nil Int64Value")
+	}
+	if w := ToInt64Value(jagain.Some[int64](9)); w.GetValue() != 9 {
+		t.Errorf("Expected Some(9) to convert to Int64Value(9), got %v", w)
+	}
+	if w := ToDoubleValue(jagain.Some(1.5)); w.GetValue() != 1.5 {
+		t.Errorf("Expected Some(1.5) to convert to DoubleValue(1.5), got %v", w)
+	}
+	if w := ToBytesValue(jagain.Some([]byte("b"))); string(w.GetValue()) != "b" {
+		t.Errorf("Expected Some(b) to convert to BytesValue(b), got %v", w)
+	}
+}
+
+func TestTimestampAndDuration(t *testing.T) {
+	now := time.Now().UTC()
+	if o := FromTimestamp(ToTimestamp(jagain.Some(now))); !o.Unwrap().Equal(now) {
+		t.Errorf("Expected timestamp round trip to preserve the time, got %v", o)
+	}
+	if ToTimestamp(jagain.None[time.Time]()) != nil || FromTimestamp(nil).IsSome() {
+		t.Errorf("Expected None to map to a nil Timestamp and back")
+	}
+
+	if o := FromDuration(ToDuration(jagain.Some(time.Second))); o.UnwrapOr(0) != time.Second {
+		t.Errorf("Expected duration round trip to preserve the duration, got %v", o)
+	}
+	if ToDuration(jagain.None[time.Duration]()) != nil || FromDuration(nil).IsSome() {
+		t.Errorf("Expected None to map to a nil Duration and back")
+	}
+}
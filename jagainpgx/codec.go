@@ -0,0 +1,120 @@
+package jagainpgx
+
+import (
+	"database/sql"
+	"reflect"
+	"strings"
+
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+// registeredOIDs are the types whose codecs Register wraps.
+var registeredOIDs = []uint32{
+	pgtype.TextOID, pgtype.VarcharOID, pgtype.BPCharOID, pgtype.NameOID,
+	pgtype.Int2OID, pgtype.Int4OID, pgtype.Int8OID,
+	pgtype.Float4OID, pgtype.Float8OID, pgtype.NumericOID,
+	pgtype.BoolOID, pgtype.ByteaOID, pgtype.UUIDOID,
+	pgtype.DateOID, pgtype.TimestampOID, pgtype.TimestamptzOID, pgtype.IntervalOID,
+	pgtype.JSONOID, pgtype.JSONBOID,
+}
+
+// Register wraps the codecs of common PostgreSQL types in m so that Option values are
+// encoded and scanned through the column's own codec: None maps to NULL, and Some values
+// of any type the codec supports work, including structs stored as json or jsonb.
+// Without it pgx falls back to Option's sql.Scanner and driver.Valuer implementations.
+//
+// Call it for every connection, for example from pgxpool.Config.AfterConnect:
+//
+//	config.AfterConnect = func(ctx context.Context, conn *pgx.Conn) error {
+//		jagainpgx.Register(conn.TypeMap())
+//		return nil
+//	}
+func Register(m *pgtype.Map) {
+	for _, oid := range registeredOIDs {
+		t, ok := m.TypeForOID(oid)
+		if !ok {
+			continue
+		}
+		if _, done := t.Codec.(*optionCodec); done {
+			continue
+		}
+		m.RegisterType(&pgtype.Type{Name: t.Name, OID: t.OID, Codec: &optionCodec{Codec: t.Codec}})
+	}
+}
+
+// optionCodec handles Option values and targets before delegating to the wrapped codec.
+type optionCodec struct {
+	pgtype.Codec
+}
+
+// PlanEncode implements pgtype.Codec.
+func (c *optionCodec) PlanEncode(m *pgtype.Map, oid uint32, format int16, value any) pgtype.EncodePlan {
+	if elem, ok := optionElem(reflect.TypeOf(value)); ok {
+		next := m.PlanEncode(oid, format, reflect.Zero(elem).Interface())
+		if next == nil {
+			return nil
+		}
+		return &optionEncodePlan{next: next}
+	}
+	return c.Codec.PlanEncode(m, oid, format, value)
+}
+
+// PlanScan implements pgtype.Codec.
+// Options holding a type with its own sql.Scanner keep using Option.Scan, which delegates to it.
+func (c *optionCodec) PlanScan(m *pgtype.Map, oid uint32, format int16, target any) pgtype.ScanPlan {
+	if t := reflect.TypeOf(target); t != nil && t.Kind() == reflect.Pointer {
+		if elem, ok := optionElem(t.Elem()); ok && !reflect.PointerTo(elem).Implements(scannerType) {
+			return &optionScanPlan{m: m, oid: oid, format: format, elem: elem}
+		}
+	}
+	return c.Codec.PlanScan(m, oid, format, target)
+}
+
+var scannerType = reflect.TypeFor[sql.Scanner]()
+
+// optionElem reports whether t is a jagain.Option and returns its element type.
+func optionElem(t reflect.Type) (reflect.Type, bool) {
+	if t == nil || t.PkgPath() != "github.com/dendianugerah/jagain" || !strings.HasPrefix(t.Name(), "Option[") {
+		return nil, false
+	}
+	toPtr, ok := t.MethodByName("ToPtr")
+	if !ok {
+		return nil, false
+	}
+	return toPtr.Type.Out(0).Elem(), true
+}
+
+type optionEncodePlan struct {
+	next pgtype.EncodePlan
+}
+
+// Encode writes NULL for None and the contained value otherwise.
+func (p *optionEncodePlan) Encode(value any, buf []byte) ([]byte, error) {
+	ptr := reflect.ValueOf(value).MethodByName("ToPtr").Call(nil)[0]
+	if ptr.IsNil() {
+		return nil, nil
+	}
+	return p.next.Encode(ptr.Elem().Interface(), buf)
+}
+
+type optionScanPlan struct {
+	m      *pgtype.Map
+	oid    uint32
+	format int16
+	elem   reflect.Type
+}
+
+// Scan decodes NULL as None and anything else through the codec for the element type.
+// The decoded value is stored with Option.Scan, which assigns a value of the element type as-is.
+func (p *optionScanPlan) Scan(src []byte, dst any) error {
+	scanner := dst.(sql.Scanner)
+	if src == nil {
+		return scanner.Scan(nil)
+	}
+
+	value := reflect.New(p.elem)
+	if err := p.m.Scan(p.oid, p.format, src, value.Interface()); err != nil {
+		return err
+	}
+	return scanner.Scan(value.Elem().Interface())
+}
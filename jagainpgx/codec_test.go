@@ -0,0 +1,85 @@
+package jagainpgx
+
+import (
+	"testing"
+	"time"
+
+	"github.com/dendianugerah/jagain"
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+type settings struct {
+	Theme string `json:"theme"`
+}
+
+// roundTrip encodes value with m and scans the result into target.
+func roundTrip(t *testing.T, m *pgtype.Map, oid uint32, value, target any) {
+	t.Helper()
+	buf, err := m.Encode(oid, pgtype.BinaryFormatCode, value, nil)
+	if err != nil {
+		t.Fatalf("Failed to encode %T: %v", value, err)
+	}
+	if err := m.Scan(oid, pgtype.BinaryFormatCode, buf, target); err != nil {
+		t.Fatalf("Failed to scan into %T: %v", target, err)
+	}
+}
+
+func TestRegister(t *testing.T) {
+	m := pgtype.NewMap()
+	Register(m)
+	Register(m) // Registering twice must not double-wrap
+
+	// Test scalar values
+	var name jagain.Option[string]
+	roundTrip(t, m, pgtype.TextOID, jagain.Some("jane"), &name)
+	if name.UnwrapOr("") != "jane" {
+		t.Errorf("Expected text to round trip as Some(\"jane\"), got %v", name)
+	}
+
+	var age jagain.Option[int64]
+	roundTrip(t, m, pgtype.Int8OID, jagain.Some[int64](30), &age)
+	if age.UnwrapOr(0) != 30 {
+		t.Errorf("Expected int8 to round trip as Some(30), got %v", age)
+	}
+
+	// Test None encodes as NULL and NULL scans as None
+	age = jagain.Some[int64](1)
+	roundTrip(t, m, pgtype.Int8OID, jagain.None[int64](), &age)
+	if !age.IsNone() {
+		t.Errorf("Expected NULL to scan as None, got %v", age)
+	}
+
+	// Test timestamptz
+	now := time.Now().Truncate(time.Microsecond)
+	var created jagain.Option[time.Time]
+	roundTrip(t, m, pgtype.TimestamptzOID, jagain.Some(now), &created)
+	if !created.Unwrap().Equal(now) {
+		t.Errorf("Expected timestamptz to round trip, got %v", created)
+	}
+
+	// Test uuid as a byte array
+	id := [16]byte{0xde, 0xad, 0xbe, 0xef}
+	var uid jagain.Option[[16]byte]
+	roundTrip(t, m, pgtype.UUIDOID, jagain.Some(id), &uid)
+	if uid.UnwrapOr([16]byte{}) != id {
+		t.Errorf("Expected uuid to round trip, got %v", uid)
+	}
+
+	// Test jsonb into a struct
+	var prefs jagain.Option[settings]
+	roundTrip(t, m, pgtype.JSONBOID, jagain.Some(settings{Theme: "dark"}), &prefs)
+	if prefs.UnwrapOr(settings{}).Theme != "dark" {
+		t.Errorf("Expected jsonb to round trip into a struct, got %v", prefs)
+	}
+	roundTrip(t, m, pgtype.JSONBOID, jagain.None[settings](), &prefs)
+	if !prefs.IsNone() {
+		t.Errorf("Expected NULL jsonb to scan as None, got %v", prefs)
+	}
+
+	// Test element types with their own sql.Scanner keep working
+	var text jagain.Option[pgtype.Text]
+	roundTrip(t, m, pgtype.TextOID, "x", &text)
+	if !text.IsSome() || text.Unwrap().String != "x" {
+		t.Errorf("Expected Option[pgtype.Text] to scan, got %v", text)
+	}
+}
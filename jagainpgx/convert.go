@@ -0,0 +1,141 @@
+// Package jagainpgx connects jagain Options with pgx v5.
+// It converts between Options and pgtype values, and Register teaches a pgtype.Map to
+// encode and scan Option values through the column's own codec.
+package jagainpgx
+
+import (
+	"time"
+
+	"github.com/dendianugerah/jagain"
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+// FromText converts a pgtype.Text to an Option.
+func FromText(v pgtype.Text) jagain.Option[string] {
+	if !v.Valid {
+		return jagain.None[string]()
+	}
+	return jagain.Some(v.String)
+}
+
+// ToText converts an Option to a pgtype.Text.
+func ToText(o jagain.Option[string]) pgtype.Text {
+	if o.IsNone() {
+		return pgtype.Text{}
+	}
+	return pgtype.Text{String: o.Unwrap(), Valid: true}
+}
+
+// FromInt8 converts a pgtype.Int8 to an Option.
+func FromInt8(v pgtype.Int8) jagain.Option[int64] {
+	if !v.Valid {
+		return jagain.None[int64]()
+	}
+	return jagain.Some(v.Int64)
+}
+
+// ToInt8 converts an Option to a pgtype.Int8.
+func ToInt8(o jagain.Option[int64]) pgtype.Int8 {
+	if o.IsNone() {
+		return pgtype.Int8{}
+	}
+	return pgtype.Int8{Int64: o.Unwrap(), Valid: true}
+}
+
+// FromInt4 converts a pgtype.Int4 to an Option.
+func FromInt4(v pgtype.Int4) jagain.Option[int32] {
+	if !v.Valid {
+		return jagain.None[int32]()
+	}
+	return jagain.Some(v.Int32)
+}
+
+// ToInt4 converts an Option to a pgtype.Int4.
+func ToInt4(o jagain.Option[int32]) pgtype.Int4 {
+	if o.IsNone() {
+		return pgtype.Int4{}
+	}
+	return pgtype.Int4{Int32: o.Unwrap(), Valid: true}
+}
+
+// FromFloat8 converts a pgtype.Float8 to an Option.
+func FromFloat8(v pgtype.Float8) jagain.Option[float64] {
+	if !v.Valid {
+		return jagain.None[float64]()
+	}
+	return jagain.Some(v.Float64)
+}
+
+// ToFloat8 converts an Option to a pgtype.Float8.
+func ToFloat8(o jagain.Option[float64]) pgtype.Float8 {
+	if o.IsNone() {
+		return pgtype.Float8{}
+	}
+	return pgtype.Float8{Float64: o.Unwrap(), Valid: true}
+}
+
+// FromBool converts a pgtype.Bool to an Option.
+func FromBool(v pgtype.Bool) jagain.Option[bool] {
+	if !v.Valid {
+		return jagain.None[bool]()
+	}
+	return jagain.Some(v.Bool)
+}
+
+// ToBool converts an Option to a pgtype.Bool.
+func ToBool(o jagain.Option[bool]) pgtype.Bool {
+	if o.IsNone() {
+		return pgtype.Bool{}
+	}
+	return pgtype.Bool{Bool: o.Unwrap(), Valid: true}
+}
+
+// FromTimestamptz converts a pgtype.Timestamptz to an Option.
+// Infinite timestamps have no time.Time equivalent and become None.
+func FromTimestamptz(v pgtype.Timestamptz) jagain.Option[time.Time] {
+	if !v.Valid || v.InfinityModifier != pgtype.Finite {
+		return jagain.None[time.Time]()
+	}
+	return jagain.Some(v.Time)
+}
+
+// ToTimestamptz converts an Option to a pgtype.Timestamptz.
+func ToTimestamptz(o jagain.Option[time.Time]) pgtype.Timestamptz {
+	if o.IsNone() {
+		return pgtype.Timestamptz{}
+	}
+	return pgtype.Timestamptz{Time: o.Unwrap(), Valid: true}
+}
+
+// FromDate converts a pgtype.Date to an Option.
+// Infinite dates have no time.Time equivalent and become None.
+func FromDate(v pgtype.Date) jagain.Option[time.Time] {
+	if !v.Valid || v.InfinityModifier != pgtype.Finite {
+		return jagain.None[time.Time]()
+	}
+	return jagain.Some(v.Time)
+}
+
+// ToDate converts an Option to a pgtype.Date.
+func ToDate(o jagain.Option[time.Time]) pgtype.Date {
+	if o.IsNone() {
+		return pgtype.Date{}
+	}
+	return pgtype.Date{Time: o.Unwrap(), Valid: true}
+}
+
+// FromUUID converts a pgtype.UUID to an Option.
+func FromUUID(v pgtype.UUID) jagain.Option[[16]byte] {
+	if !v.Valid {
+		return jagain.None[[16]byte]()
+	}
+	return jagain.Some(v.Bytes)
+}
+
+// ToUUID converts an Option to a pgtype.UUID.
+func ToUUID(o jagain.Option[[16]byte]) pgtype.UUID {
+	if o.IsNone() {
+		return pgtype.UUID{}
+	}
+	return pgtype.UUID{Bytes: o.Unwrap(), Valid: true}
+}
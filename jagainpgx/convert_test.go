@@ -0,0 +1,48 @@
+package jagainpgx
+
+import (
+	"testing"
+	"time"
+
+	"github.com/dendianugerah/jagain"
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+func TestConverters(t *testing.T) {
+	// Test Text
+	if FromText(pgtype.Text{}).IsSome() {
+		t.Errorf("Expected invalid Text to be None")
+	}
+	if o := FromText(pgtype.Text{String: "a", Valid: true}); o.UnwrapOr("") != "a" {
+		t.Errorf("Expected valid Text to be Some(\"a\"), got %v", o)
+	}
+	if v := ToText(jagain.Some("a")); !v.Valid || v.String != "a" {
+		t.Errorf("Expected Some(\"a\") to convert to a valid Text, got %v", v)
+	}
+
+	// Test Int8 and Int4
+	if o := FromInt8(pgtype.Int8{Int64: 5, Valid: true}); o.UnwrapOr(0) != 5 {
+		t.Errorf("Expected valid Int8 to be Some(5), got %v", o)
+	}
+	if v := ToInt4(jagain.None[int32]()); v.Valid {
+		t.Errorf("Expected None to convert to an invalid Int4")
+	}
+
+	// Test Timestamptz, including infinity
+	now := time.Now()
+	if o := FromTimestamptz(ToTimestamptz(jagain.Some(now))); !o.Unwrap().Equal(now) {
+		t.Errorf("Expected Timestamptz round trip to preserve the time, got %v", o)
+	}
+	if o := FromTimestamptz(pgtype.Timestamptz{InfinityModifier: pgtype.Infinity, Valid: true}); o.IsSome() {
+		t.Errorf("Expected infinite Timestamptz to be None, got %v", o)
+	}
+
+	// Test UUID
+	id := [16]byte{1, 2, 3}
+	if o := FromUUID(ToUUID(jagain.Some(id))); o.UnwrapOr([16]byte{}) != id {
+		t.Errorf("Expected UUID round trip to preserve the bytes, got %v", o)
+	}
+	if v := ToUUID(jagain.None[[16]byte]()); v.Valid {
+		t.Errorf("Expected None to convert to an invalid UUID")
+	}
+}
@@ -0,0 +1,12 @@
+module github.com/dendianugerah/jagain/jagainpgx
+
+go 1.25.0
+
+require (
+	github.com/dendianugerah/jagain v0.0.0-00010101000000-000000000000
+	github.com/jackc/pgx/v5 v5.11.0
+)
+
+require github.com/google/go-cmp v0.7.0 // indirect
+
+replace github.com/dendianugerah/jagain => ..
@@ -0,0 +1,53 @@
+// Package jagainprom exports jagain metrics to Prometheus.
+package jagainprom
+
+import (
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+// Metrics implements jagain.Metrics with a counter of results by label and outcome and a
+// histogram of durations by label.
+type Metrics struct {
+	results   *prometheus.CounterVec
+	durations *prometheus.HistogramVec
+}
+
+// New creates Metrics whose collectors are named with namespace and registered with reg.
+// Install it with jagain.SetMetrics.
+func New(reg prometheus.Registerer, namespace string) (*Metrics, error) {
+	m := &Metrics{
+		results: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: namespace,
+			Name:      "results_total",
+			Help:      "Number of Results by operation and outcome.",
+		}, []string{"label", "outcome"}),
+		durations: prometheus.NewHistogramVec(prometheus.HistogramOpts{
+			Namespace: namespace,
+			Name:      "duration_seconds",
+			Help:      "Duration of Result-returning operations.",
+			Buckets:   prometheus.DefBuckets,
+		}, []string{"label"}),
+	}
+	for _, c := range []prometheus.Collector{m.results, m.durations} {
+		if err := reg.Register(c); err != nil {
+			return nil, err
+		}
+	}
+	return m, nil
+}
+
+// CountResult implements jagain.Metrics, using the outcome label values "ok" and "err".
+func (m *Metrics) CountResult(label string, ok bool) {
+	outcome := "err"
+	if ok {
+		outcome = "ok"
+	}
+	m.results.WithLabelValues(label, outcome).Inc()
+}
+
+// ObserveDuration implements jagain.Metrics.
+func (m *Metrics) ObserveDuration(label string, d time.Duration) {
+	m.durations.WithLabelValues(label).Observe(d.Seconds())
+}
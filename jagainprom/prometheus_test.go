@@ -0,0 +1,39 @@
+package jagainprom
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/dendianugerah/jagain"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+)
+
+func TestMetrics(t *testing.T) {
+	reg := prometheus.NewRegistry()
+	m, err := New(reg, "app")
+	if err != nil {
+		t.Fatalf("Failed to create metrics: %v", err)
+	}
+	jagain.SetMetrics(m)
+	defer jagain.SetMetrics(nil)
+
+	jagain.Observe("lookup", func() jagain.Result[int] { return jagain.Ok(1) })
+	jagain.Observe("lookup", func() jagain.Result[int] { return jagain.Err[int](errors.New("miss")) })
+	jagain.Observe("lookup", func() jagain.Result[int] { return jagain.Err[int](errors.New("miss")) })
+
+	if got := testutil.ToFloat64(m.results.WithLabelValues("lookup", "ok")); got != 1 {
+		t.Errorf("Expected 1 ok, got %v", got)
+	}
+	if got := testutil.ToFloat64(m.results.WithLabelValues("lookup", "err")); got != 2 {
+		t.Errorf("Expected 2 err, got %v", got)
+	}
+	if got := testutil.CollectAndCount(m.durations, "app_duration_seconds"); got != 1 {
+		t.Errorf("Expected one histogram series, got %d", got)
+	}
+
+	// Test duplicate registration
+	if _, err := New(reg, "app"); err == nil {
+		t.Errorf("Expected registering twice to fail")
+	}
+}
@@ -0,0 +1,16 @@
+module github.com/dendianugerah/jagain/jagainsamber
+
+go 1.23
+
+require (
+	github.com/dendianugerah/jagain v0.0.0-00010101000000-000000000000
+	github.com/samber/lo v1.53.0
+	github.com/samber/mo v1.17.0
+)
+
+require (
+	github.com/google/go-cmp v0.7.0 // indirect
+	golang.org/x/text v0.22.0 // indirect
+)
+
+replace github.com/dendianugerah/jagain => ..
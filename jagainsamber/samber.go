@@ -0,0 +1,62 @@
+// Package jagainsamber converts between jagain Options and Results and the types of
+// github.com/samber/mo and github.com/samber/lo, so code using either library can adopt
+// jagain one package at a time.
+package jagainsamber
+
+import (
+	"github.com/dendianugerah/jagain"
+	"github.com/samber/lo"
+	"github.com/samber/mo"
+)
+
+// FromMoOption converts a mo.Option to an Option.
+func FromMoOption[T any](o mo.Option[T]) jagain.Option[T] {
+	value, ok := o.Get()
+	if !ok {
+		return jagain.None[T]()
+	}
+	return jagain.Some(value)
+}
+
+// ToMoOption converts an Option to a mo.Option.
+func ToMoOption[T any](o jagain.Option[T]) mo.Option[T] {
+	if o.IsNone() {
+		return mo.None[T]()
+	}
+	return mo.Some(o.Unwrap())
+}
+
+// FromMoResult converts a mo.Result to a Result.
+func FromMoResult[T any](r mo.Result[T]) jagain.Result[T] {
+	value, err := r.Get()
+	if err != nil {
+		return jagain.Err[T](err)
+	}
+	return jagain.Ok(value)
+}
+
+// ToMoResult converts a Result to a mo.Result.
+func ToMoResult[T any](r jagain.Result[T]) mo.Result[T] {
+	if r.IsErr() {
+		return mo.Err[T](r.UnwrapErr())
+	}
+	return mo.Ok(r.Unwrap())
+}
+
+// FromLoTuple converts a value and error pair, as returned by lo's Try and Async helpers,
+// to a Result.
+func FromLoTuple[T any](t lo.Tuple2[T, error]) jagain.Result[T] {
+	if t.B != nil {
+		return jagain.Err[T](t.B)
+	}
+	return jagain.Ok(t.A)
+}
+
+// ToLoTuple converts a Result to a value and error pair, with the zero value of T for an Err.
+func ToLoTuple[T any](r jagain.Result[T]) lo.Tuple2[T, error] {
+	if r.IsErr() {
+		var zero T
+		return lo.T2(zero, r.UnwrapErr())
+	}
+	return lo.T2[T, error](r.Unwrap(), nil)
+}
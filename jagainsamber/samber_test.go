@@ -0,0 +1,66 @@
+package jagainsamber
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/dendianugerah/jagain"
+	"github.com/samber/lo"
+	"github.com/samber/mo"
+)
+
+var errFailed = errors.New("failed")
+
+func TestMoOption(t *testing.T) {
+	// Test FromMoOption
+	if o := FromMoOption(mo.Some(42)); o.UnwrapOr(0) != 42 {
+		t.Errorf("Expected Some(42), got %v", o)
+	}
+	if o := FromMoOption(mo.None[int]()); o.IsSome() {
+		t.Errorf("Expected None, got %v", o)
+	}
+
+	// Test ToMoOption
+	if o := ToMoOption(jagain.Some("a")); o.OrEmpty() != "a" {
+		t.Errorf("Expected Some(a), got %v", o)
+	}
+	if o := ToMoOption(jagain.None[string]()); o.IsPresent() {
+		t.Errorf("Expected None, got %v", o)
+	}
+}
+
+func TestMoResult(t *testing.T) {
+	// Test FromMoResult
+	if r := FromMoResult(mo.Ok(42)); r.UnwrapOr(0) != 42 {
+		t.Errorf("Expected Ok(42), got %v", r)
+	}
+	if r := FromMoResult(mo.Err[int](errFailed)); !r.IsErr() || !errors.Is(r.UnwrapErr(), errFailed) {
+		t.Errorf("Expected Err(failed), got %v", r)
+	}
+
+	// Test ToMoResult
+	if r := ToMoResult(jagain.Ok("a")); r.OrEmpty() != "a" {
+		t.Errorf("Expected Ok(a), got %v", r)
+	}
+	if r := ToMoResult(jagain.Err[string](errFailed)); r.Error() != errFailed {
+		t.Errorf("Expected Err(failed), got %v", r)
+	}
+}
+
+func TestLoTuple(t *testing.T) {
+	// Test FromLoTuple
+	if r := FromLoTuple(lo.T2[int, error](42, nil)); r.UnwrapOr(0) != 42 {
+		t.Errorf("Expected Ok(42), got %v", r)
+	}
+	if r := FromLoTuple(lo.T2(0, errFailed)); !r.IsErr() {
+		t.Errorf("Expected Err(failed), got %v", r)
+	}
+
+	// Test ToLoTuple
+	if tup := ToLoTuple(jagain.Ok("a")); tup.A != "a" || tup.B != nil {
+		t.Errorf("Expected (a, nil), got %v", tup)
+	}
+	if tup := ToLoTuple(jagain.Err[string](errFailed)); tup.A != "" || tup.B != errFailed {
+		t.Errorf("Expected (\"\", failed), got %v", tup)
+	}
+}
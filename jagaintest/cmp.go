@@ -0,0 +1,57 @@
+package jagaintest
+
+import (
+	"reflect"
+	"strings"
+
+	"github.com/dendianugerah/jagain"
+	"github.com/google/go-cmp/cmp"
+)
+
+// CmpOptions returns go-cmp options that compare Options and Results by their contents,
+// so cmp.Diff and cmp.Equal work on structs containing them without an Exporter.
+// Some and Ok values are compared recursively, None equals None, and Err results are
+// equal when their error messages are.
+func CmpOptions() cmp.Option {
+	return cmp.FilterValues(func(x, y any) bool {
+		return isOption(reflect.TypeOf(x)) || isResult(reflect.TypeOf(x))
+	}, cmp.Transformer("jagain", cmpValue))
+}
+
+type cmpSome struct{ Value any }
+type cmpNone struct{}
+type cmpOk struct{ Value any }
+type cmpErr struct{ Err string }
+
+// isOption reports whether t is a jagain.Option.
+func isOption(t reflect.Type) bool {
+	return t != nil && t.PkgPath() == jagainPath && strings.HasPrefix(t.Name(), "Option[")
+}
+
+// isResult reports whether t is a jagain.Result.
+func isResult(t reflect.Type) bool {
+	return t != nil && t.PkgPath() == jagainPath && strings.HasPrefix(t.Name(), "Result[")
+}
+
+var jagainPath = reflect.TypeFor[jagain.Option[int]]().PkgPath()
+
+// cmpValue returns the contents of the Option or Result x in a form cmp can compare.
+func cmpValue(x any) any {
+	v := reflect.ValueOf(x)
+	if isOption(v.Type()) {
+		ptr := v.MethodByName("ToPtr").Call(nil)[0]
+		if ptr.IsNil() {
+			return cmpNone{}
+		}
+		return cmpSome{ptr.Elem().Interface()}
+	}
+
+	if v.MethodByName("IsOk").Call(nil)[0].Bool() {
+		return cmpOk{v.MethodByName("Unwrap").Call(nil)[0].Interface()}
+	}
+	err, _ := v.MethodByName("UnwrapErr").Call(nil)[0].Interface().(error)
+	if err == nil {
+		return cmpErr{"<nil>"}
+	}
+	return cmpErr{err.Error()}
+}
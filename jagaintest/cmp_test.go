@@ -0,0 +1,56 @@
+package jagaintest
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/dendianugerah/jagain"
+	"github.com/google/go-cmp/cmp"
+)
+
+type cmpProfile struct {
+	Name    string
+	Email   jagain.Option[string]
+	Friends []jagain.Option[cmpProfile]
+	Status  jagain.Result[int]
+}
+
+func TestCmpOptions(t *testing.T) {
+	a := cmpProfile{
+		Name:    "ann",
+		Email:   jagain.Some("ann@example.com"),
+		Friends: []jagain.Option[cmpProfile]{jagain.Some(cmpProfile{Name: "bob", Status: jagain.Ok(1)}), jagain.None[cmpProfile]()},
+		Status:  jagain.Err[int](errors.New("offline")),
+	}
+	b := a
+	b.Friends = []jagain.Option[cmpProfile]{jagain.Some(cmpProfile{Name: "bob", Status: jagain.Ok(1)}), jagain.None[cmpProfile]()}
+	b.Status = jagain.Err[int](errors.New("offline"))
+
+	// Test equal values, including separately created errors
+	if diff := cmp.Diff(a, b, CmpOptions()); diff != "" {
+		t.Errorf("Expected no diff, got:\n%s", diff)
+	}
+
+	// Test differences are reported inside Options
+	b.Email = jagain.None[string]()
+	b.Friends[0] = jagain.Some(cmpProfile{Name: "bob", Status: jagain.Ok(2)})
+	diff := cmp.Diff(a, b, CmpOptions())
+	if !strings.Contains(diff, "ann@example.com") || !strings.Contains(diff, "Value: int(1)") {
+		t.Errorf("Expected the diff to show the email and status, got:\n%s", diff)
+	}
+
+	// Test cmp.Equal
+	if cmp.Equal(jagain.Ok(1), jagain.Err[int](errors.New("x")), CmpOptions()) {
+		t.Errorf("Expected Ok and Err to differ")
+	}
+
+	// Test the zero Result, an Err without an error
+	var zero jagain.Result[int]
+	if !cmp.Equal(zero, jagain.Result[int]{}, CmpOptions()) {
+		t.Errorf("Expected zero Results to be equal")
+	}
+	if cmp.Equal(zero, jagain.Err[int](errors.New("x")), CmpOptions()) {
+		t.Errorf("Expected the zero Result to differ from an Err with an error")
+	}
+}
@@ -0,0 +1,17 @@
+module github.com/dendianugerah/jagain/jagaintest
+
+go 1.23.0
+
+require (
+	github.com/dendianugerah/jagain v0.0.0-00010101000000-000000000000
+	github.com/google/go-cmp v0.7.0
+	github.com/stretchr/testify v1.12.1
+	go.uber.org/mock v0.6.0
+)
+
+require (
+	github.com/stretchr/objx v0.5.3 // indirect
+	go.yaml.in/yaml/v3 v3.0.5 // indirect
+)
+
+replace github.com/dendianugerah/jagain => ..
@@ -0,0 +1,124 @@
+// Package jagaintest provides test assertions for jagain Options and Results.
+//
+// The Assert functions report a failure with t.Errorf and return whether the check passed;
+// the Require functions stop the test with t.Fatalf and return the unwrapped value.
+// Equality checks print a diff of the expected and actual values on failure.
+// QuickOption and QuickResult let testing/quick generate Options and Results.
+package jagaintest
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+
+	"github.com/dendianugerah/jagain"
+	"github.com/google/go-cmp/cmp"
+)
+
+// AssertOk checks that r is Ok.
+func AssertOk[T any](t testing.TB, r jagain.Result[T]) bool {
+	t.Helper()
+	if r.IsErr() {
+		t.Errorf("Expected Ok, got %v", r)
+		return false
+	}
+	return true
+}
+
+// RequireOk checks that r is Ok and returns its value, stopping the test otherwise.
+func RequireOk[T any](t testing.TB, r jagain.Result[T]) T {
+	t.Helper()
+	if r.IsErr() {
+		t.Fatalf("Expected Ok, got %v", r)
+	}
+	return r.Unwrap()
+}
+
+// AssertOkEqual checks that r is Ok and holds want.
+func AssertOkEqual[T any](t testing.TB, r jagain.Result[T], want T) bool {
+	t.Helper()
+	return AssertOk(t, r) && assertEqual(t, r.Unwrap(), want)
+}
+
+// AssertErr checks that r is Err.
+func AssertErr[T any](t testing.TB, r jagain.Result[T]) bool {
+	t.Helper()
+	if r.IsOk() {
+		t.Errorf("Expected Err, got %v", r)
+		return false
+	}
+	return true
+}
+
+// RequireErr checks that r is Err and returns its error, stopping the test otherwise.
+func RequireErr[T any](t testing.TB, r jagain.Result[T]) error {
+	t.Helper()
+	if r.IsOk() {
+		t.Fatalf("Expected Err, got %v", r)
+	}
+	return r.UnwrapErr()
+}
+
+// AssertErrIs checks that r is Err with an error matching target according to errors.Is.
+func AssertErrIs[T any](t testing.TB, r jagain.Result[T], target error) bool {
+	t.Helper()
+	if !AssertErr(t, r) {
+		return false
+	}
+	if !errors.Is(r.UnwrapErr(), target) {
+		t.Errorf("Expected error matching %q, got %q", target, r.UnwrapErr())
+		return false
+	}
+	return true
+}
+
+// AssertSome checks that o is Some.
+func AssertSome[T any](t testing.TB, o jagain.Option[T]) bool {
+	t.Helper()
+	if o.IsNone() {
+		t.Errorf("Expected Some, got None")
+		return false
+	}
+	return true
+}
+
+// RequireSome checks that o is Some and returns its value, stopping the test otherwise.
+func RequireSome[T any](t testing.TB, o jagain.Option[T]) T {
+	t.Helper()
+	if o.IsNone() {
+		t.Fatalf("Expected Some, got None")
+	}
+	return o.Unwrap()
+}
+
+// AssertSomeEqual checks that o is Some and holds want.
+func AssertSomeEqual[T any](t testing.TB, o jagain.Option[T], want T) bool {
+	t.Helper()
+	return AssertSome(t, o) && assertEqual(t, o.Unwrap(), want)
+}
+
+// AssertNone checks that o is None.
+func AssertNone[T any](t testing.TB, o jagain.Option[T]) bool {
+	t.Helper()
+	if o.IsSome() {
+		t.Errorf("Expected None, got %v", o)
+		return false
+	}
+	return true
+}
+
+// cmpOptions compare Options and Results by their contents and every other field,
+// including unexported ones.
+var cmpOptions = []cmp.Option{
+	CmpOptions(),
+	cmp.Exporter(func(reflect.Type) bool { return true }),
+}
+
+func assertEqual[T any](t testing.TB, got, want T) bool {
+	t.Helper()
+	if diff := cmp.Diff(want, got, cmpOptions...); diff != "" {
+		t.Errorf("Unexpected value (-want +got):\n%s", diff)
+		return false
+	}
+	return true
+}
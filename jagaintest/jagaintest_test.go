@@ -0,0 +1,121 @@
+package jagaintest
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/dendianugerah/jagain"
+)
+
+// recorder is a testing.TB that records failures instead of failing the test.
+type recorder struct {
+	testing.TB
+	errors []string
+	fatal  bool
+}
+
+type fatalPanic struct{}
+
+func (r *recorder) Helper() {}
+
+func (r *recorder) Errorf(format string, args ...any) {
+	r.errors = append(r.errors, fmt.Sprintf(format, args...))
+}
+
+func (r *recorder) Fatalf(format string, args ...any) {
+	r.Errorf(format, args...)
+	r.fatal = true
+	panic(fatalPanic{})
+}
+
+// run calls f with a fresh recorder, stopping at the first Fatalf.
+func run(f func(t testing.TB)) (rec *recorder) {
+	rec = &recorder{}
+	defer func() {
+		if v := recover(); v != nil && v != (fatalPanic{}) {
+			panic(v)
+		}
+	}()
+	f(rec)
+	return rec
+}
+
+type user struct {
+	Name string
+	Age  int
+}
+
+var errMissing = errors.New("missing")
+
+func TestResultAssertions(t *testing.T) {
+	// Test passing assertions
+	rec := run(func(t testing.TB) {
+		AssertOk(t, jagain.Ok(1))
+		AssertOkEqual(t, jagain.Ok(user{"ann", 30}), user{"ann", 30})
+		if RequireOk(t, jagain.Ok(2)) != 2 {
+			t.Errorf("wrong value")
+		}
+		AssertErr(t, jagain.Err[int](errMissing))
+		AssertErrIs(t, jagain.Err[int](fmt.Errorf("load: %w", errMissing)), errMissing)
+		RequireErr(t, jagain.Err[int](errMissing))
+	})
+	if len(rec.errors) != 0 {
+		t.Errorf("Expected no failures, got %v", rec.errors)
+	}
+
+	// Test failures are reported
+	rec = run(func(t testing.TB) {
+		AssertOk(t, jagain.Err[int](errMissing))
+		AssertErr(t, jagain.Ok(1))
+		AssertErrIs(t, jagain.Err[int](errors.New("other")), errMissing)
+	})
+	if len(rec.errors) != 3 || rec.fatal {
+		t.Errorf("Expected three non-fatal failures, got %v", rec.errors)
+	}
+
+	// Test diff printing
+	rec = run(func(t testing.TB) {
+		AssertOkEqual(t, jagain.Ok(user{"ann", 30}), user{"ann", 31})
+	})
+	if len(rec.errors) != 1 || !strings.Contains(rec.errors[0], "-want +got") || !strings.Contains(rec.errors[0], "Age") {
+		t.Errorf("Expected a diff naming Age, got %v", rec.errors)
+	}
+
+	// Test Require stops the test
+	rec = run(func(t testing.TB) {
+		RequireOk(t, jagain.Err[int](errMissing))
+		t.Errorf("not reached")
+	})
+	if !rec.fatal || len(rec.errors) != 1 {
+		t.Errorf("Expected RequireOk to stop the test, got %v", rec.errors)
+	}
+}
+
+func TestOptionAssertions(t *testing.T) {
+	// Test passing assertions
+	rec := run(func(t testing.TB) {
+		AssertSome(t, jagain.Some("a"))
+		AssertSomeEqual(t, jagain.Some([]int{1, 2}), []int{1, 2})
+		AssertNone(t, jagain.None[string]())
+		if RequireSome(t, jagain.Some("b")) != "b" {
+			t.Errorf("wrong value")
+		}
+	})
+	if len(rec.errors) != 0 {
+		t.Errorf("Expected no failures, got %v", rec.errors)
+	}
+
+	// Test failures are reported
+	rec = run(func(t testing.TB) {
+		AssertSome(t, jagain.None[string]())
+		AssertNone(t, jagain.Some("a"))
+		AssertSomeEqual(t, jagain.Some("a"), "b")
+		RequireSome(t, jagain.None[string]())
+		t.Errorf("not reached")
+	})
+	if !rec.fatal || len(rec.errors) != 4 {
+		t.Errorf("Expected four failures ending in a fatal one, got %v", rec.errors)
+	}
+}
@@ -0,0 +1,73 @@
+package jagaintest
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/dendianugerah/jagain"
+	"github.com/google/go-cmp/cmp"
+)
+
+// Matcher matches Options and Results passed to or returned from mocks.
+// It has the same method set as gomock.Matcher, so matchers can be used directly in
+// gomock expectations. With testify, pass m.Matches to mock.MatchedBy.
+type Matcher interface {
+	Matches(x any) bool
+	String() string
+}
+
+type matcher struct {
+	match func(x any) bool
+	desc  string
+}
+
+func (m matcher) Matches(x any) bool { return m.match(x) }
+func (m matcher) String() string     { return m.desc }
+
+// IsSomeEq matches an Option[T] that is Some and holds a value equal to want.
+func IsSomeEq[T any](want T) Matcher {
+	return matcher{
+		match: func(x any) bool {
+			o, ok := x.(jagain.Option[T])
+			return ok && o.IsSome() && cmp.Equal(o.Unwrap(), want, cmpOptions...)
+		},
+		desc: fmt.Sprintf("is Some(%v)", want),
+	}
+}
+
+// IsNone matches an Option[T] that is None.
+func IsNone[T any]() Matcher {
+	return matcher{
+		match: func(x any) bool {
+			o, ok := x.(jagain.Option[T])
+			return ok && o.IsNone()
+		},
+		desc: "is None",
+	}
+}
+
+// IsOkMatching matches a Result[T] that is Ok and whose value satisfies pred.
+func IsOkMatching[T any](pred func(T) bool) Matcher {
+	return matcher{
+		match: func(x any) bool {
+			r, ok := x.(jagain.Result[T])
+			return ok && r.IsOk() && pred(r.Unwrap())
+		},
+		desc: "is Ok matching predicate",
+	}
+}
+
+// IsErrIs matches a Result of any type that is Err with an error matching target
+// according to errors.Is.
+func IsErrIs(target error) Matcher {
+	return matcher{
+		match: func(x any) bool {
+			r, ok := x.(interface {
+				IsErr() bool
+				UnwrapErr() error
+			})
+			return ok && r.IsErr() && errors.Is(r.UnwrapErr(), target)
+		},
+		desc: fmt.Sprintf("is Err matching %q", target),
+	}
+}
@@ -0,0 +1,58 @@
+package jagaintest
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/dendianugerah/jagain"
+	"github.com/stretchr/testify/mock"
+	"go.uber.org/mock/gomock"
+)
+
+var _ gomock.Matcher = IsSomeEq(1)
+
+type store struct {
+	mock.Mock
+}
+
+func (s *store) Save(r jagain.Result[int]) {
+	s.Called(r)
+}
+
+func TestMatchers(t *testing.T) {
+	// Test IsSomeEq and IsNone
+	if !IsSomeEq([]string{"a"}).Matches(jagain.Some([]string{"a"})) {
+		t.Errorf("Expected IsSomeEq to match an equal value")
+	}
+	if IsSomeEq(1).Matches(jagain.Some(2)) || IsSomeEq(1).Matches(jagain.None[int]()) || IsSomeEq(1).Matches(1) {
+		t.Errorf("Expected IsSomeEq to reject other values, None and non-Options")
+	}
+	if !IsNone[int]().Matches(jagain.None[int]()) || IsNone[int]().Matches(jagain.Some(1)) {
+		t.Errorf("Expected IsNone to match only None")
+	}
+
+	// Test IsOkMatching
+	positive := IsOkMatching(func(v int) bool { return v > 0 })
+	if !positive.Matches(jagain.Ok(3)) || positive.Matches(jagain.Ok(-3)) || positive.Matches(jagain.Err[int](errMissing)) {
+		t.Errorf("Expected IsOkMatching to apply the predicate to Ok values only")
+	}
+
+	// Test IsErrIs with any result type
+	if !IsErrIs(errMissing).Matches(jagain.Err[string](fmt.Errorf("load: %w", errMissing))) {
+		t.Errorf("Expected IsErrIs to match wrapped errors")
+	}
+	if IsErrIs(errMissing).Matches(jagain.Ok("x")) {
+		t.Errorf("Expected IsErrIs to reject Ok")
+	}
+
+	// Test String for gomock failure messages
+	if got := IsSomeEq(1).String(); got != "is Some(1)" {
+		t.Errorf("Expected description 'is Some(1)', got %q", got)
+	}
+
+	// Test use with testify mocks
+	s := &store{}
+	s.On("Save", mock.MatchedBy(IsErrIs(errMissing).Matches)).Return().Once()
+	s.Save(jagain.Err[int](errMissing))
+	s.AssertExpectations(t)
+}
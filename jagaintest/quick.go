@@ -0,0 +1,42 @@
+package jagaintest
+
+import (
+	"math/rand"
+	"reflect"
+	"testing/quick"
+
+	"github.com/dendianugerah/jagain"
+)
+
+// QuickOption wraps an Option so testing/quick can generate it. Use it as the argument
+// type of a property and read the Option from the embedded field.
+// One in four generated Options is None; the rest hold a value generated by quick.Value.
+type QuickOption[T any] struct {
+	jagain.Option[T]
+}
+
+// Generate implements quick.Generator.
+func (QuickOption[T]) Generate(r *rand.Rand, size int) reflect.Value {
+	return reflect.ValueOf(QuickOption[T]{jagain.GenOption(r, quickValue[T])})
+}
+
+// QuickResult wraps a Result so testing/quick can generate it.
+// One in four generated Results is an Err; the rest hold a value generated by quick.Value.
+type QuickResult[T any] struct {
+	jagain.Result[T]
+}
+
+// Generate implements quick.Generator.
+func (QuickResult[T]) Generate(r *rand.Rand, size int) reflect.Value {
+	return reflect.ValueOf(QuickResult[T]{jagain.GenResult(r, quickValue[T])})
+}
+
+// quickValue generates a T with quick.Value, falling back to the zero value for
+// types quick cannot generate.
+func quickValue[T any](r *rand.Rand) T {
+	var value T
+	if v, ok := quick.Value(reflect.TypeFor[T](), r); ok {
+		reflect.ValueOf(&value).Elem().Set(v)
+	}
+	return value
+}
@@ -0,0 +1,30 @@
+package jagaintest
+
+import (
+	"testing"
+	"testing/quick"
+)
+
+func TestQuick(t *testing.T) {
+	// Test quick.Check with Option and Result arguments
+	var some, none, ok, errs int
+	f := func(o QuickOption[int], r QuickResult[string]) bool {
+		if o.IsSome() {
+			some++
+		} else {
+			none++
+		}
+		if r.IsOk() {
+			ok++
+		} else {
+			errs++
+		}
+		return o.Map(func(v int) int { return v }).UnwrapOr(0) == o.UnwrapOr(0)
+	}
+	if err := quick.Check(f, &quick.Config{MaxCount: 200}); err != nil {
+		t.Errorf("Expected the property to hold, got %v", err)
+	}
+	if some == 0 || none == 0 || ok == 0 || errs == 0 {
+		t.Errorf("Expected every variant to be generated, got %d/%d Some/None and %d/%d Ok/Err", some, none, ok, errs)
+	}
+}
@@ -0,0 +1,12 @@
+module github.com/dendianugerah/jagain/jagainuuid
+
+go 1.23
+
+require (
+	github.com/dendianugerah/jagain v0.0.0-00010101000000-000000000000
+	github.com/google/uuid v1.6.0
+)
+
+require github.com/google/go-cmp v0.7.0 // indirect
+
+replace github.com/dendianugerah/jagain => ..
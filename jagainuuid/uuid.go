@@ -0,0 +1,30 @@
+// Package jagainuuid connects jagain Options and Results with github.com/google/uuid.
+//
+// Option[uuid.UUID] needs no extra code to be stored or sent: it encodes to JSON as a
+// string or null, scans from and writes to SQL columns, and reads from text. This package
+// adds the parsing helpers that turn identifier strings into Options and Results.
+package jagainuuid
+
+import (
+	"github.com/dendianugerah/jagain"
+	"github.com/google/uuid"
+)
+
+// ParseUUIDR parses s as a UUID in any of the forms accepted by uuid.Parse.
+func ParseUUIDR(s string) jagain.Result[uuid.UUID] {
+	id, err := uuid.Parse(s)
+	if err != nil {
+		return jagain.Err[uuid.UUID](err)
+	}
+	return jagain.Ok(id)
+}
+
+// UUIDFromStringOption parses s as a UUID, returning None for the empty string. Invalid
+// input is None too; use ParseUUIDR to learn why it was rejected.
+func UUIDFromStringOption(s string) jagain.Option[uuid.UUID] {
+	if s == "" {
+		return jagain.None[uuid.UUID]()
+	}
+	return ParseUUIDR(s).ToOption()
+}
+
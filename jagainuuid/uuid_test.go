@@ -0,0 +1,61 @@
+package jagainuuid
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/dendianugerah/jagain"
+	"github.com/google/uuid"
+)
+
+const sample = "6ba7b810-9dad-11d1-80b4-00c04fd430c8"
+
+func TestParse(t *testing.T) {
+	// Test ParseUUIDR
+	if r := ParseUUIDR(sample); r.IsErr() || r.Unwrap().String() != sample {
+		t.Errorf("Expected Ok(%s), got %v", sample, r)
+	}
+	if r := ParseUUIDR("nope"); r.IsOk() {
+		t.Errorf("Expected an error for invalid input")
+	}
+
+	// Test UUIDFromStringOption
+	if !UUIDFromStringOption("").IsNone() || !UUIDFromStringOption("nope").IsNone() {
+		t.Errorf("Expected empty and invalid input to be None")
+	}
+	if o := UUIDFromStringOption(sample); o.IsNone() || o.Unwrap().String() != sample {
+		t.Errorf("Expected Some(%s), got %v", sample, o)
+	}
+}
+
+func TestOptionUUIDEncoding(t *testing.T) {
+	id := uuid.MustParse(sample)
+
+	// Test JSON
+	data, err := json.Marshal(struct {
+		ID     jagain.Option[uuid.UUID] `json:"id"`
+		Parent jagain.Option[uuid.UUID] `json:"parent"`
+	}{ID: jagain.Some(id)})
+	if err != nil || string(data) != `{"id":"`+sample+`","parent":null}` {
+		t.Errorf("Expected the UUID as a string and None as null, got %s (%v)", data, err)
+	}
+	var decoded jagain.Option[uuid.UUID]
+	if err := json.Unmarshal([]byte(`"`+sample+`"`), &decoded); err != nil || decoded.Unwrap() != id {
+		t.Errorf("Expected to decode Some(%s), got %v (%v)", sample, decoded, err)
+	}
+
+	// Test SQL
+	v, err := jagain.Some(id).Value()
+	if err != nil || v != sample {
+		t.Errorf("Expected the driver value %q, got %v (%v)", sample, v, err)
+	}
+	var scanned jagain.Option[uuid.UUID]
+	for _, src := range []any{sample, []byte(sample)} {
+		if err := scanned.Scan(src); err != nil || scanned.Unwrap() != id {
+			t.Errorf("Expected to scan %T into Some(%s), got %v (%v)", src, sample, scanned, err)
+		}
+	}
+	if err := scanned.Scan(nil); err != nil || !scanned.IsNone() {
+		t.Errorf("Expected NULL to scan as None, got %v (%v)", scanned, err)
+	}
+}
@@ -0,0 +1,95 @@
+// Package jagainvalidator lets go-playground/validator check structs with jagain.Option fields.
+package jagainvalidator
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"strings"
+	"time"
+
+	"github.com/dendianugerah/jagain"
+	"github.com/go-playground/validator/v10"
+)
+
+// Default is the validator used by ValidateR. It is created by New.
+var Default = New()
+
+// New creates a validator that reports fields by their json names and understands
+// Options of the common scalar types and time.Time. Options of other types are added
+// with RegisterOption.
+func New() *validator.Validate {
+	v := validator.New(validator.WithRequiredStructEnabled())
+	v.RegisterTagNameFunc(func(f reflect.StructField) string {
+		name, _, _ := strings.Cut(f.Tag.Get("json"), ",")
+		switch name {
+		case "-":
+			return ""
+		case "":
+			return f.Name
+		}
+		return name
+	})
+	Register(v,
+		jagain.Option[string]{}, jagain.Option[bool]{},
+		jagain.Option[int]{}, jagain.Option[int8]{}, jagain.Option[int16]{}, jagain.Option[int32]{}, jagain.Option[int64]{},
+		jagain.Option[uint]{}, jagain.Option[uint8]{}, jagain.Option[uint16]{}, jagain.Option[uint32]{}, jagain.Option[uint64]{},
+		jagain.Option[float32]{}, jagain.Option[float64]{},
+		jagain.Option[time.Time]{},
+	)
+	return v
+}
+
+// RegisterOption registers Option[T] with v.
+func RegisterOption[T any](v *validator.Validate) {
+	Register(v, jagain.Option[T]{})
+}
+
+// Register registers the types of the given Option values with v. The validator then
+// sees None as an absent value, so `required` means Some, and sees Some as the value it
+// holds, so the remaining rules apply to it. Tag optional fields with omitempty to skip
+// their rules when they are None.
+//
+// Like validator.Validate.RegisterCustomTypeFunc, Register must be called before validating.
+func Register(v *validator.Validate, options ...any) {
+	v.RegisterCustomTypeFunc(optionValue, options...)
+}
+
+// optionValue unwraps an Option for the validator, returning nil for None.
+func optionValue(field reflect.Value) any {
+	ptr := field.MethodByName("ToPtr").Call(nil)[0]
+	if ptr.IsNil() {
+		return nil
+	}
+	return ptr.Elem().Interface()
+}
+
+// ValidateR validates value with Default.
+func ValidateR[T any](value T) jagain.Result[T] {
+	return ValidateRWith(Default, value)
+}
+
+// ValidateRWith validates the struct value with v and returns it unchanged when it is valid.
+// Rule violations are reported as jagain.ValidationErrors, with the path of each field
+// taken from the validator's namespace without the leading struct name.
+func ValidateRWith[T any](v *validator.Validate, value T) jagain.Result[T] {
+	err := v.Struct(value)
+	if err == nil {
+		return jagain.Ok(value)
+	}
+
+	var verrs validator.ValidationErrors
+	if !errors.As(err, &verrs) {
+		return jagain.Err[T](err)
+	}
+	errs := make(jagain.ValidationErrors, len(verrs))
+	for i, fe := range verrs {
+		_, path, _ := strings.Cut(fe.Namespace(), ".")
+		rule := fe.Tag()
+		if fe.Param() != "" {
+			rule += "=" + fe.Param()
+		}
+		errs[i] = &jagain.FieldError{Path: path, Err: fmt.Errorf("failed on the %q rule", rule)}
+	}
+	return jagain.Err[T](errs)
+}
@@ -0,0 +1,61 @@
+package jagainvalidator
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/dendianugerah/jagain"
+)
+
+type address struct {
+	Zip string `json:"zipCode" validate:"len=5"`
+}
+
+type profile struct {
+	Name      string                 `json:"name" validate:"required"`
+	Email     jagain.Option[string]  `json:"email" validate:"required,email"`
+	Nickname  jagain.Option[string]  `json:"nickname" validate:"omitempty,min=3"`
+	Age       jagain.Option[int]     `json:"age" validate:"omitempty,gte=18"`
+	Billing   jagain.Option[address] `json:"billing" validate:"omitempty"`
+	Addresses []address              `json:"addresses" validate:"dive"`
+}
+
+func TestValidateR(t *testing.T) {
+	RegisterOption[address](Default)
+
+	// Test a valid struct with None fields
+	valid := profile{Name: "Jane", Email: jagain.Some("jane@example.com")}
+	if r := ValidateR(valid); r.IsErr() {
+		t.Errorf("Expected valid profile to pass, got %v", r.UnwrapErr())
+	}
+
+	// Test rules applied to Some values
+	r := ValidateR(profile{
+		Email:     jagain.None[string](),
+		Nickname:  jagain.Some("jj"),
+		Age:       jagain.Some(12),
+		Billing:   jagain.Some(address{Zip: "1"}),
+		Addresses: []address{{Zip: "12345"}, {Zip: "123"}},
+	})
+	if r.IsOk() {
+		t.Fatalf("Expected invalid profile to fail")
+	}
+	msg := r.UnwrapErr().Error()
+	for _, want := range []string{
+		`name: failed on the "required" rule`,
+		`email: failed on the "required" rule`,
+		`nickname: failed on the "min=3" rule`,
+		`age: failed on the "gte=18" rule`,
+		`addresses[1].zipCode: failed on the "len=5" rule`,
+	} {
+		if !strings.Contains(msg, want) {
+			t.Errorf("Expected error to contain %q, got:\n%s", want, msg)
+		}
+	}
+
+	// Test that an invalid email is checked when Some
+	bad := ValidateR(profile{Name: "Jane", Email: jagain.Some("not-an-email")})
+	if bad.IsOk() || !strings.Contains(bad.UnwrapErr().Error(), `email: failed on the "email" rule`) {
+		t.Errorf("Expected invalid email to fail, got %v", bad)
+	}
+}
@@ -0,0 +1,38 @@
+//go:build goexperiment.jsonv2 && go1.27
+
+package jagain
+
+import (
+	"encoding/json/jsontext"
+	"encoding/json/v2"
+)
+
+// MarshalJSONTo implements the json.MarshalerTo interface of encoding/json/v2.
+// None is written as null and Some writes its value directly to the encoder, honoring
+// the encoder's options.
+func (o Option[T]) MarshalJSONTo(enc *jsontext.Encoder) error {
+	if !o.valid {
+		return enc.WriteToken(jsontext.Null)
+	}
+	return json.MarshalEncode(enc, &o.value)
+}
+
+// UnmarshalJSONFrom implements the json.UnmarshalerFrom interface of encoding/json/v2.
+// A JSON null decodes to None.
+func (o *Option[T]) UnmarshalJSONFrom(dec *jsontext.Decoder) error {
+	if dec.PeekKind() == 'n' {
+		if _, err := dec.ReadToken(); err != nil {
+			return err
+		}
+		*o = None[T]()
+		return nil
+	}
+
+	var value T
+	if err := json.UnmarshalDecode(dec, &value); err != nil {
+		return err
+	}
+
+	*o = Some(value)
+	return nil
+}
@@ -0,0 +1,45 @@
+//go:build goexperiment.jsonv2 && go1.27
+
+package jagain
+
+import (
+	"encoding/json/jsontext"
+	"encoding/json/v2"
+	"testing"
+)
+
+func TestOptionJSONv2(t *testing.T) {
+	type profile struct {
+		Name  string                 `json:"name"`
+		Email Option[string]         `json:"email"`
+		Tags  Option[[]string]       `json:"tags,omitzero"`
+		Meta  Option[map[string]int] `json:"meta"`
+	}
+
+	// Test marshaling
+	bytes, err := json.Marshal(profile{Name: "Jane", Meta: Some(map[string]int{"b": 2, "a": 1})}, json.Deterministic(true))
+	if err != nil {
+		t.Fatalf("Failed to marshal: %v", err)
+	}
+	if string(bytes) != `{"name":"Jane","email":null,"meta":{"a":1,"b":2}}` {
+		t.Errorf("Unexpected JSON: %s", bytes)
+	}
+
+	// Test encoder options reach the inner value
+	bytes, err = json.Marshal(Some("<b>"), jsontext.EscapeForHTML(true))
+	if err != nil || string(bytes) != `"\u003cb\u003e"` {
+		t.Errorf("Expected encoder options to apply, got %s (%v)", bytes, err)
+	}
+
+	// Test unmarshaling
+	var decoded profile
+	if err := json.Unmarshal([]byte(`{"name":"Jane","email":"jane@example.com","tags":null}`), &decoded); err != nil {
+		t.Fatalf("Failed to unmarshal: %v", err)
+	}
+	if decoded.Email.UnwrapOr("") != "jane@example.com" || !decoded.Tags.IsNone() || !decoded.Meta.IsNone() {
+		t.Errorf("Unexpected decoded value: %+v", decoded)
+	}
+	if err := json.Unmarshal([]byte(`{"email":5}`), &decoded); err == nil {
+		t.Errorf("Expected type mismatch to fail")
+	}
+}
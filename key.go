@@ -0,0 +1,26 @@
+package jagain
+
+// OptionKey is a comparable form of an Option, for use as a map key or in set membership
+// checks. Options themselves should not be compared with ==: in debug builds they record
+// where they were created, so two Options holding the same value are not equal.
+type OptionKey[T comparable] struct {
+	Value T
+	Valid bool
+}
+
+// KeyOf returns the comparable key of o. Keys of two Options are equal exactly when both
+// are None or both hold equal values.
+func KeyOf[T comparable](o Option[T]) OptionKey[T] {
+	if !o.valid {
+		return OptionKey[T]{}
+	}
+	return OptionKey[T]{Value: o.value, Valid: true}
+}
+
+// Option converts the key back to an Option.
+func (k OptionKey[T]) Option() Option[T] {
+	if !k.Valid {
+		return None[T]()
+	}
+	return Some(k.Value)
+}
@@ -0,0 +1,30 @@
+package jagain
+
+import "testing"
+
+func TestKeyOf(t *testing.T) {
+	// Test equal Options have equal keys
+	if KeyOf(Some(1)) != KeyOf(Some(1)) || KeyOf(None[int]()) != KeyOf(None[int]()) {
+		t.Errorf("Expected equal Options to have equal keys")
+	}
+	if KeyOf(Some(0)) == KeyOf(None[int]()) {
+		t.Errorf("Expected Some(0) and None to have different keys")
+	}
+
+	// Test deduplication in a set
+	seen := make(map[OptionKey[string]]bool)
+	for _, o := range []Option[string]{Some("a"), None[string](), Some("a"), None[string](), Some("b")} {
+		seen[KeyOf(o)] = true
+	}
+	if len(seen) != 3 {
+		t.Errorf("Expected 3 distinct keys, got %d", len(seen))
+	}
+
+	// Test round trip
+	if o := KeyOf(Some("a")).Option(); o.UnwrapOr("") != "a" {
+		t.Errorf("Expected Some(a), got %v", o)
+	}
+	if o := KeyOf(None[string]()).Option(); o.IsSome() {
+		t.Errorf("Expected None, got %v", o)
+	}
+}
@@ -0,0 +1,65 @@
+package jagain
+
+import (
+	"context"
+	"fmt"
+)
+
+// AcquireError is the error carried by an Err result when a Limiter slot could not be acquired.
+// It wraps the context error that interrupted the wait.
+type AcquireError struct {
+	Cause error
+}
+
+// Error implements the error interface.
+func (e *AcquireError) Error() string {
+	return fmt.Sprintf("limiter acquire: %v", e.Cause)
+}
+
+// Unwrap returns the context error that interrupted the wait.
+func (e *AcquireError) Unwrap() error {
+	return e.Cause
+}
+
+// Limiter caps the number of concurrently executing functions.
+type Limiter struct {
+	sem chan struct{}
+}
+
+// NewLimiter creates a Limiter allowing at most n concurrent executions.
+// A non-positive n is treated as one.
+func NewLimiter(n int) *Limiter {
+	if n <= 0 {
+		n = 1
+	}
+	return &Limiter{sem: make(chan struct{}, n)}
+}
+
+// acquire waits for a free slot or for the context to be done.
+func (l *Limiter) acquire(ctx context.Context) error {
+	if err := ctx.Err(); err != nil {
+		return &AcquireError{Cause: err}
+	}
+	select {
+	case l.sem <- struct{}{}:
+		return nil
+	case <-ctx.Done():
+		return &AcquireError{Cause: ctx.Err()}
+	}
+}
+
+func (l *Limiter) release() {
+	<-l.sem
+}
+
+// Limit wraps f so that calls to it are subject to the Limiter's concurrency cap.
+// If the context is done before a slot is acquired, an Err containing an *AcquireError is returned.
+func Limit[T any](l *Limiter, f func(context.Context) Result[T]) func(context.Context) Result[T] {
+	return func(ctx context.Context) Result[T] {
+		if err := l.acquire(ctx); err != nil {
+			return Err[T](err)
+		}
+		defer l.release()
+		return f(ctx)
+	}
+}
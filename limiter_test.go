@@ -0,0 +1,56 @@
+package jagain
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestLimiter(t *testing.T) {
+	limiter := NewLimiter(2)
+
+	var inFlight, peak int32
+	work := Limit(limiter, func(ctx context.Context) Result[int] {
+		n := atomic.AddInt32(&inFlight, 1)
+		for {
+			p := atomic.LoadInt32(&peak)
+			if n <= p || atomic.CompareAndSwapInt32(&peak, p, n) {
+				break
+			}
+		}
+		time.Sleep(5 * time.Millisecond)
+		atomic.AddInt32(&inFlight, -1)
+		return Ok(1)
+	})
+
+	var wg sync.WaitGroup
+	for i := 0; i < 8; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if r := work(context.Background()); !r.IsOk() {
+				t.Errorf("Expected limited call to succeed, got %v", r)
+			}
+		}()
+	}
+	wg.Wait()
+
+	if peak > 2 {
+		t.Errorf("Expected at most 2 concurrent executions, got %d", peak)
+	}
+
+	// Test acquisition failure on a cancelled context
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	r := work(ctx)
+	var acquireErr *AcquireError
+	if !r.IsErr() || !errors.As(r.UnwrapErr(), &acquireErr) {
+		t.Fatalf("Expected AcquireError on cancelled context, got %v", r)
+	}
+	if !errors.Is(r.UnwrapErr(), context.Canceled) {
+		t.Errorf("Expected AcquireError to wrap context.Canceled")
+	}
+}
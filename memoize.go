@@ -0,0 +1,74 @@
+package jagain
+
+import (
+	"container/list"
+	"sync"
+)
+
+// MemoizeOption configures Memoize.
+type MemoizeOption func(*memoizeConfig)
+
+type memoizeConfig struct {
+	cacheErrors bool
+	maxEntries  int
+}
+
+// MemoizeErrors makes Memoize cache Err results too, so a failing key is not retried.
+// By default only Ok results are cached, and failures are retried on the next call.
+func MemoizeErrors() MemoizeOption {
+	return func(c *memoizeConfig) {
+		c.cacheErrors = true
+	}
+}
+
+// MemoizeMaxEntries bounds the number of cached results. When the bound is reached, the
+// oldest entry is evicted. A non-positive n means no bound, which is the default.
+func MemoizeMaxEntries(n int) MemoizeOption {
+	return func(c *memoizeConfig) {
+		c.maxEntries = n
+	}
+}
+
+type memoEntry[K comparable, V any] struct {
+	key    K
+	result Result[V]
+}
+
+// Memoize wraps f so that its result for each key is computed once and then served from
+// a cache. The returned function is safe for concurrent use; concurrent first calls for
+// the same key may each run f, and SharedLoader should be used when that matters.
+func Memoize[K comparable, V any](f func(K) Result[V], opts ...MemoizeOption) func(K) Result[V] {
+	var cfg memoizeConfig
+	for _, opt := range opts {
+		opt(&cfg)
+	}
+
+	var mu sync.Mutex
+	entries := make(map[K]*list.Element)
+	order := list.New()
+
+	return func(key K) Result[V] {
+		mu.Lock()
+		if e, ok := entries[key]; ok {
+			mu.Unlock()
+			return e.Value.(*memoEntry[K, V]).result
+		}
+		mu.Unlock()
+
+		result := f(key)
+		if !result.valid && !cfg.cacheErrors {
+			return result
+		}
+
+		mu.Lock()
+		defer mu.Unlock()
+		if _, ok := entries[key]; !ok {
+			entries[key] = order.PushBack(&memoEntry[K, V]{key: key, result: result})
+			if cfg.maxEntries > 0 && order.Len() > cfg.maxEntries {
+				oldest := order.Remove(order.Front()).(*memoEntry[K, V])
+				delete(entries, oldest.key)
+			}
+		}
+		return result
+	}
+}
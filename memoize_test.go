@@ -0,0 +1,65 @@
+package jagain
+
+import (
+	"errors"
+	"sync"
+	"testing"
+)
+
+func TestMemoize(t *testing.T) {
+	calls := map[int]int{}
+	var mu sync.Mutex
+	lookup := func(k int) Result[int] {
+		mu.Lock()
+		calls[k]++
+		mu.Unlock()
+		if k < 0 {
+			return Err[int](errors.New("negative"))
+		}
+		return Ok(k * 10)
+	}
+
+	// Test Ok results are cached and Err results retried by default
+	f := Memoize(lookup)
+	for range 3 {
+		if f(1).UnwrapOr(0) != 10 {
+			t.Errorf("Expected Ok(10)")
+		}
+		f(-1)
+	}
+	if calls[1] != 1 || calls[-1] != 3 {
+		t.Errorf("Expected one call for 1 and three for -1, got %v", calls)
+	}
+
+	// Test caching errors
+	clear(calls)
+	f = Memoize(lookup, MemoizeErrors())
+	f(-1)
+	if r := f(-1); r.IsOk() || calls[-1] != 1 {
+		t.Errorf("Expected the cached error, got %v after %d calls", r, calls[-1])
+	}
+
+	// Test the size bound evicts the oldest entry
+	clear(calls)
+	f = Memoize(lookup, MemoizeMaxEntries(2))
+	f(1)
+	f(2)
+	f(3)
+	f(2)
+	f(1)
+	if calls[1] != 2 || calls[2] != 1 || calls[3] != 1 {
+		t.Errorf("Expected 1 to be evicted and recomputed, got %v", calls)
+	}
+
+	// Test concurrent use
+	f = Memoize(lookup)
+	var wg sync.WaitGroup
+	for i := range 50 {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			f(i % 5)
+		}()
+	}
+	wg.Wait()
+}
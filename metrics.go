@@ -0,0 +1,56 @@
+package jagain
+
+import (
+	"net/http"
+	"sync/atomic"
+	"time"
+)
+
+// Metrics receives observations about Results from the package's adapters.
+// Labels identify the operation, such as "http GET /users/{id}" for Handler or the name
+// passed to Observe. Implementations must be safe for concurrent use.
+type Metrics interface {
+	// CountResult counts one Ok or Err outcome.
+	CountResult(label string, ok bool)
+	// ObserveDuration records how long an operation took.
+	ObserveDuration(label string, d time.Duration)
+}
+
+type nopMetrics struct{}
+
+func (nopMetrics) CountResult(string, bool)              {}
+func (nopMetrics) ObserveDuration(string, time.Duration) {}
+
+type metricsHolder struct{ Metrics }
+
+var metrics atomic.Pointer[metricsHolder]
+
+func init() {
+	SetMetrics(nil)
+}
+
+// SetMetrics installs m as the destination of all observations. A nil m disables them.
+func SetMetrics(m Metrics) {
+	if m == nil {
+		m = nopMetrics{}
+	}
+	metrics.Store(&metricsHolder{m})
+}
+
+// Observe calls f, counting its outcome and recording its duration under label.
+func Observe[T any](label string, f func() Result[T]) Result[T] {
+	r, elapsed := Timed(f)
+	m := metrics.Load()
+	m.CountResult(label, r.valid)
+	m.ObserveDuration(label, elapsed)
+	return r
+}
+
+// httpLabel names a request for metrics by its method and the ServeMux pattern that
+// matched it, falling back to the method alone to keep label cardinality bounded.
+func httpLabel(r *http.Request) string {
+	if r.Pattern != "" {
+		return "http " + r.Pattern
+	}
+	return "http " + r.Method
+}
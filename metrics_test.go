@@ -0,0 +1,54 @@
+package jagain
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+)
+
+type recordingMetrics struct {
+	mu        sync.Mutex
+	outcomes  map[string][]bool
+	durations map[string]int
+}
+
+func (m *recordingMetrics) CountResult(label string, ok bool) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.outcomes[label] = append(m.outcomes[label], ok)
+}
+
+func (m *recordingMetrics) ObserveDuration(label string, d time.Duration) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.durations[label]++
+}
+
+func TestMetrics(t *testing.T) {
+	m := &recordingMetrics{outcomes: map[string][]bool{}, durations: map[string]int{}}
+	SetMetrics(m)
+	defer SetMetrics(nil)
+
+	// Test Observe
+	Observe("lookup", func() Result[int] { return Ok(1) })
+	Observe("lookup", func() Result[int] { return Err[int](errors.New("miss")) })
+	if got := m.outcomes["lookup"]; len(got) != 2 || !got[0] || got[1] {
+		t.Errorf("Expected one Ok and one Err, got %v", got)
+	}
+	if m.durations["lookup"] != 2 {
+		t.Errorf("Expected two durations, got %d", m.durations["lookup"])
+	}
+
+	// Test the HTTP adapter reports by route
+	mux := http.NewServeMux()
+	mux.Handle("GET /users/{id}", Handler(func(r *http.Request) Result[string] {
+		return Ok(r.PathValue("id"))
+	}))
+	mux.ServeHTTP(httptest.NewRecorder(), httptest.NewRequest(http.MethodGet, "/users/7", nil))
+	if got := m.outcomes["http GET /users/{id}"]; len(got) != 1 || !got[0] {
+		t.Errorf("Expected the route to be counted, got %v", m.outcomes)
+	}
+}
@@ -0,0 +1,52 @@
+package jagain
+
+import (
+	"errors"
+	"reflect"
+)
+
+// joinErrorType is the dynamic type of errors created by errors.Join.
+var joinErrorType = reflect.TypeOf(errors.Join(errors.New("")))
+
+// AppendErr adds errs to the Result's error with errors.Join, so a Result can report a
+// primary failure together with secondary ones such as cleanup errors. Nil errors are
+// ignored. An Ok result becomes an Err when at least one non-nil error is given.
+func AppendErr[T any](r Result[T], errs ...error) Result[T] {
+	var all []error
+	if !r.valid {
+		all = Errors(r)
+	}
+	added := false
+	for _, err := range errs {
+		if err != nil {
+			all = append(all, err)
+			added = true
+		}
+	}
+	if !added {
+		return r
+	}
+	if r.valid {
+		return Err[T](errors.Join(all...))
+	}
+	return errResult[T](errors.Join(all...))
+}
+
+// Errors returns the individual errors of an Err result: the errors joined by AppendErr
+// or errors.Join, or a single error otherwise. It returns nil for an Ok result.
+func Errors[T any](r Result[T]) []error {
+	if r.valid {
+		return nil
+	}
+	err := r.err
+	if st, ok := err.(*stackError); ok {
+		err = st.err
+	}
+	if err == nil {
+		return nil
+	}
+	if reflect.TypeOf(err) == joinErrorType {
+		return err.(interface{ Unwrap() []error }).Unwrap()
+	}
+	return []error{err}
+}
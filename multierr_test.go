@@ -0,0 +1,43 @@
+package jagain
+
+import (
+	"errors"
+	"io"
+	"os"
+	"testing"
+)
+
+func TestAppendErr(t *testing.T) {
+	errQuery := errors.New("query failed")
+
+	// Test appending to an Err
+	r := AppendErr(Err[int](errQuery), io.ErrClosedPipe, nil)
+	if errs := Errors(r); len(errs) != 2 || errs[0] != errQuery || errs[1] != io.ErrClosedPipe {
+		t.Errorf("Expected two errors, got %v", errs)
+	}
+	if !errors.Is(r.UnwrapErr(), errQuery) || !errors.Is(r.UnwrapErr(), io.ErrClosedPipe) {
+		t.Errorf("Expected errors.Is to match every appended error")
+	}
+
+	// Test that repeated appends stay flat
+	r = AppendErr(r, os.ErrClosed)
+	if errs := Errors(r); len(errs) != 3 || errs[2] != os.ErrClosed {
+		t.Errorf("Expected three flat errors, got %v", errs)
+	}
+
+	// Test appending to an Ok
+	if ok := AppendErr(Ok(1), nil); !ok.IsOk() {
+		t.Errorf("Expected Ok to stay Ok when only nil errors are appended")
+	}
+	if failed := AppendErr(Ok(1), io.EOF); !failed.IsErr() || len(Errors(failed)) != 1 {
+		t.Errorf("Expected Ok to become Err, got %v", failed)
+	}
+
+	// Test Errors on plain results
+	if Errors(Ok(1)) != nil {
+		t.Errorf("Expected no errors for Ok")
+	}
+	if errs := Errors(Err[int](errQuery)); len(errs) != 1 || errs[0] != errQuery {
+		t.Errorf("Expected a single error, got %v", errs)
+	}
+}
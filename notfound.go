@@ -0,0 +1,61 @@
+package jagain
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+)
+
+// ErrNotFound reports that a looked-up entity does not exist. Every *NotFoundError
+// matches it with errors.Is.
+var ErrNotFound = errors.New("not found")
+
+// NotFoundError reports that the entity identified by Key does not exist, distinguishing
+// a missing entity from a failed lookup.
+type NotFoundError struct {
+	Entity string
+	Key    any
+}
+
+// Error implements the error interface.
+func (e *NotFoundError) Error() string {
+	switch {
+	case e.Entity == "":
+		return ErrNotFound.Error()
+	case e.Key == nil:
+		return e.Entity + " not found"
+	}
+	return fmt.Sprintf("%s %v not found", e.Entity, e.Key)
+}
+
+// Is reports whether target is ErrNotFound.
+func (e *NotFoundError) Is(target error) bool {
+	return target == ErrNotFound
+}
+
+// StatusCode returns 404, the HTTP status EncodeJSONError uses for the error.
+func (e *NotFoundError) StatusCode() int {
+	return http.StatusNotFound
+}
+
+// OptionToNotFound converts an Option to a Result, turning None into an Err holding a
+// *NotFoundError for entity and key.
+func OptionToNotFound[T any](o Option[T], entity string, key any) Result[T] {
+	if !o.valid {
+		return Err[T](&NotFoundError{Entity: entity, Key: key})
+	}
+	return Ok(o.value)
+}
+
+// ResultToOption converts a lookup Result to a Result of an Option, absorbing errors
+// matching ErrNotFound into Ok(None). Other errors are kept, so the caller can tell a
+// missing entity from a failed lookup.
+func ResultToOption[T any](r Result[T]) Result[Option[T]] {
+	switch {
+	case r.valid:
+		return Ok(Some(r.value))
+	case errors.Is(r.err, ErrNotFound):
+		return Ok(None[T]())
+	}
+	return Err[Option[T]](r.err)
+}
@@ -0,0 +1,66 @@
+package jagain
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"testing"
+)
+
+func TestNotFoundError(t *testing.T) {
+	// Test messages
+	tests := []struct {
+		err  *NotFoundError
+		want string
+	}{
+		{&NotFoundError{Entity: "user", Key: 42}, "user 42 not found"},
+		{&NotFoundError{Entity: "user"}, "user not found"},
+		{&NotFoundError{}, "not found"},
+	}
+	for _, tt := range tests {
+		if got := tt.err.Error(); got != tt.want {
+			t.Errorf("Expected %q, got %q", tt.want, got)
+		}
+	}
+
+	// Test matching and status
+	err := fmt.Errorf("loading: %w", &NotFoundError{Entity: "user", Key: 42})
+	if !errors.Is(err, ErrNotFound) {
+		t.Errorf("Expected NotFoundError to match ErrNotFound")
+	}
+	if HTTPStatus(err) != http.StatusNotFound {
+		t.Errorf("Expected 404, got %d", HTTPStatus(err))
+	}
+}
+
+func TestOptionToNotFound(t *testing.T) {
+	if r := OptionToNotFound(Some("jane"), "user", 42); r.UnwrapOr("") != "jane" {
+		t.Errorf("Expected Ok(jane), got %v", r)
+	}
+
+	r := OptionToNotFound(None[string](), "user", 42)
+	var nf *NotFoundError
+	if !errors.As(r.UnwrapErr(), &nf) || nf.Entity != "user" || nf.Key != 42 {
+		t.Errorf("Expected a NotFoundError for user 42, got %v", r)
+	}
+}
+
+func TestResultToOption(t *testing.T) {
+	// Test Ok becomes Some
+	if r := ResultToOption(Ok(1)); r.UnwrapOr(None[int]()).UnwrapOr(0) != 1 {
+		t.Errorf("Expected Ok(Some(1)), got %v", r)
+	}
+
+	// Test not found is absorbed into None
+	for _, err := range []error{ErrNotFound, &NotFoundError{Entity: "user"}, fmt.Errorf("query: %w", ErrNotFound)} {
+		if r := ResultToOption(Err[int](err)); !r.IsOk() || r.Unwrap().IsSome() {
+			t.Errorf("Expected Ok(None) for %v, got %v", err, r)
+		}
+	}
+
+	// Test other errors are kept
+	errDown := errors.New("database down")
+	if r := ResultToOption(Err[int](errDown)); !r.IsErr() || r.UnwrapErr() != errDown {
+		t.Errorf("Expected Err(database down), got %v", r)
+	}
+}
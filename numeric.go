@@ -0,0 +1,79 @@
+package jagain
+
+// Number is the set of numeric types accepted by the arithmetic helpers.
+type Number interface {
+	~int | ~int8 | ~int16 | ~int32 | ~int64 |
+		~uint | ~uint8 | ~uint16 | ~uint32 | ~uint64 | ~uintptr |
+		~float32 | ~float64
+}
+
+// NonePolicy decides how the arithmetic helpers treat None operands.
+type NonePolicy int
+
+const (
+	// NoneAbsorbs makes the result None if any operand is None, like NULL in SQL arithmetic.
+	NoneAbsorbs NonePolicy = iota
+	// NoneSkipped leaves None operands out, like NULL in SQL aggregates: AddOptions and
+	// SubOptions treat them as zero, and SumOptions and AvgOptions ignore them.
+	// The result is None only if every operand is None.
+	NoneSkipped
+)
+
+// AddOptions returns a + b, treating None according to policy.
+func AddOptions[N Number](a, b Option[N], policy NonePolicy) Option[N] {
+	return combineNumbers(a, b, policy, func(x, y N) N { return x + y })
+}
+
+// SubOptions returns a - b, treating None according to policy.
+func SubOptions[N Number](a, b Option[N], policy NonePolicy) Option[N] {
+	return combineNumbers(a, b, policy, func(x, y N) N { return x - y })
+}
+
+func combineNumbers[N Number](a, b Option[N], policy NonePolicy, op func(N, N) N) Option[N] {
+	if !a.valid && !b.valid || policy == NoneAbsorbs && (!a.valid || !b.valid) {
+		return None[N]()
+	}
+	return Some(op(a.value, b.value))
+}
+
+// SumOptions returns the sum of opts, treating None according to policy.
+// The sum of no Options is None.
+func SumOptions[N Number](opts []Option[N], policy NonePolicy) Option[N] {
+	var sum N
+	n := 0
+	for _, o := range opts {
+		if !o.valid {
+			if policy == NoneAbsorbs {
+				return None[N]()
+			}
+			continue
+		}
+		sum += o.value
+		n++
+	}
+	if n == 0 {
+		return None[N]()
+	}
+	return Some(sum)
+}
+
+// AvgOptions returns the mean of opts as a float64, treating None according to policy.
+// The mean of no Options is None.
+func AvgOptions[N Number](opts []Option[N], policy NonePolicy) Option[float64] {
+	var sum float64
+	n := 0
+	for _, o := range opts {
+		if !o.valid {
+			if policy == NoneAbsorbs {
+				return None[float64]()
+			}
+			continue
+		}
+		sum += float64(o.value)
+		n++
+	}
+	if n == 0 {
+		return None[float64]()
+	}
+	return Some(sum / float64(n))
+}
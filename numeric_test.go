@@ -0,0 +1,53 @@
+package jagain
+
+import "testing"
+
+func TestAddSubOptions(t *testing.T) {
+	tests := []struct {
+		name string
+		got  Option[int]
+		want Option[int]
+	}{
+		{"add", AddOptions(Some(2), Some(3), NoneAbsorbs), Some(5)},
+		{"add absorbs", AddOptions(Some(2), None[int](), NoneAbsorbs), None[int]()},
+		{"add skips", AddOptions(None[int](), Some(3), NoneSkipped), Some(3)},
+		{"add both None", AddOptions(None[int](), None[int](), NoneSkipped), None[int]()},
+		{"sub", SubOptions(Some(5), Some(3), NoneAbsorbs), Some(2)},
+		{"sub absorbs", SubOptions(None[int](), Some(3), NoneAbsorbs), None[int]()},
+		{"sub skips", SubOptions(None[int](), Some(3), NoneSkipped), Some(-3)},
+	}
+	for _, tt := range tests {
+		if KeyOf(tt.got) != KeyOf(tt.want) {
+			t.Errorf("Expected %v for %s, got %v", tt.want, tt.name, tt.got)
+		}
+	}
+}
+
+func TestSumAvgOptions(t *testing.T) {
+	values := []Option[int]{Some(1), None[int](), Some(2), Some(6)}
+
+	// Test SumOptions
+	if o := SumOptions(values, NoneSkipped); o.UnwrapOr(0) != 9 {
+		t.Errorf("Expected Some(9), got %v", o)
+	}
+	if o := SumOptions(values, NoneAbsorbs); o.IsSome() {
+		t.Errorf("Expected None, got %v", o)
+	}
+	if o := SumOptions([]Option[int]{None[int]()}, NoneSkipped); o.IsSome() {
+		t.Errorf("Expected None when every value is None, got %v", o)
+	}
+	if o := SumOptions[int](nil, NoneAbsorbs); o.IsSome() {
+		t.Errorf("Expected None for no values, got %v", o)
+	}
+
+	// Test AvgOptions divides by the number of values, not of Options
+	if o := AvgOptions(values, NoneSkipped); o.UnwrapOr(0) != 3 {
+		t.Errorf("Expected Some(3), got %v", o)
+	}
+	if o := AvgOptions([]Option[int]{Some(1), Some(2)}, NoneAbsorbs); o.UnwrapOr(0) != 1.5 {
+		t.Errorf("Expected Some(1.5), got %v", o)
+	}
+	if o := AvgOptions(values, NoneAbsorbs); o.IsSome() {
+		t.Errorf("Expected None, got %v", o)
+	}
+}
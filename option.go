@@ -6,31 +6,43 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	"reflect"
 )
 
 // ErrNoValue is returned when attempting to access a value that is not present.
 var ErrNoValue = errors.New("option contains no value")
 
 // Option represents a value that may or may not be present.
+// The value is stored inline rather than behind a pointer, so creating and copying
+// Options does not allocate.
 type Option[T any] struct {
-	value *T
+	_     origin
+	value T
 	valid bool
 }
 
+// AnyOption is implemented by every Option, whatever its type, and by no other type, so
+// reflection-driven code in other packages can recognise Options by their type.
+type AnyOption interface {
+	IsSome() bool
+	anyOption()
+}
+
+func (Option[T]) anyOption() {}
+
 // Some creates an Option containing a value.
 func Some[T any](value T) Option[T] {
 	return Option[T]{
-		value: &value,
+		value: value,
 		valid: true,
-	}
+	}.withOrigin(newOrigin())
 }
 
 // None creates an Option with no value.
 func None[T any]() Option[T] {
 	return Option[T]{
-		value: nil,
 		valid: false,
-	}
+	}.withOrigin(newOrigin())
 }
 
 // FromPtr creates an Option from a pointer.
@@ -43,6 +55,47 @@ func FromPtr[T any](ptr *T) Option[T] {
 	return Some(*ptr)
 }
 
+// NonNil creates an Option that is None if v is nil: a nil pointer, map, slice, channel or
+// function, a nil interface, or an interface holding any of these, such as a nil *MyError
+// stored in an error. Otherwise Some is returned with v; empty but non-nil maps and
+// slices are Some.
+func NonNil[T any](v T) Option[T] {
+	rv := reflect.ValueOf(v)
+	if !rv.IsValid() {
+		return None[T]()
+	}
+	switch rv.Kind() {
+	case reflect.Pointer, reflect.Map, reflect.Slice, reflect.Chan, reflect.Func, reflect.Interface, reflect.UnsafePointer:
+		if rv.IsNil() {
+			return None[T]()
+		}
+	}
+	return Some(v)
+}
+
+// NotEmptyString creates an Option that is None if s is empty.
+func NotEmptyString(s string) Option[string] {
+	return NotZero(s)
+}
+
+// NotZero creates an Option that is None if v is the zero value of T.
+func NotZero[T comparable](v T) Option[T] {
+	var zero T
+	if v == zero {
+		return None[T]()
+	}
+	return Some(v)
+}
+
+// NotEmptySlice creates an Option that is None if s has no elements, whether it is nil or
+// empty.
+func NotEmptySlice[T any](s []T) Option[[]T] {
+	if len(s) == 0 {
+		return None[[]T]()
+	}
+	return Some(s)
+}
+
 // ToPtr converts an Option to a pointer.
 // If the Option has no value, nil is returned.
 // Otherwise, a pointer to the value is returned.
@@ -50,7 +103,7 @@ func (o Option[T]) ToPtr() *T {
 	if !o.valid {
 		return nil
 	}
-	v := *o.value
+	v := o.value
 	return &v
 }
 
@@ -64,13 +117,21 @@ func (o Option[T]) IsNone() bool {
 	return !o.valid
 }
 
+// IsZero reports whether the Option is None.
+// encoding/json omits None from fields tagged omitzero, and the BSON encoder omits it
+// from fields tagged omitempty.
+func (o Option[T]) IsZero() bool {
+	return !o.valid
+}
+
 // Unwrap returns the contained value or panics if no value is present.
 // This should be used only when you are confident a value is present.
 func (o Option[T]) Unwrap() T {
 	if !o.valid {
+		unwrapPanicHooks.fire(ErrNoValue, 1)
 		panic(ErrNoValue)
 	}
-	return *o.value
+	return o.value
 }
 
 // UnwrapOr returns the contained value or the provided default if no value is present.
@@ -78,7 +139,7 @@ func (o Option[T]) UnwrapOr(defaultValue T) T {
 	if !o.valid {
 		return defaultValue
 	}
-	return *o.value
+	return o.value
 }
 
 // UnwrapOrElse returns the contained value or computes a value from the provided function.
@@ -86,7 +147,7 @@ func (o Option[T]) UnwrapOrElse(f func() T) T {
 	if !o.valid {
 		return f()
 	}
-	return *o.value
+	return o.value
 }
 
 // Map transforms the Option's value using the provided function if a value is present.
@@ -94,7 +155,25 @@ func (o Option[T]) Map(f func(T) T) Option[T] {
 	if !o.valid {
 		return o
 	}
-	return Some(f(*o.value))
+	return Some(f(o.value))
+}
+
+// MapInPlace modifies the Option's value through a pointer if a value is present.
+// Unlike Map, it does not copy the value, which matters for large structs.
+func (o *Option[T]) MapInPlace(f func(*T)) {
+	if o.valid {
+		f(&o.value)
+	}
+}
+
+// UnwrapRef returns a pointer to the contained value or panics if no value is present.
+// The pointer refers to the Option itself, so writes through it change the Option.
+func (o *Option[T]) UnwrapRef() *T {
+	if !o.valid {
+		unwrapPanicHooks.fire(ErrNoValue, 1)
+		panic(ErrNoValue)
+	}
+	return &o.value
 }
 
 // FlatMap transforms the Option's value into another Option using the provided function.
@@ -102,17 +181,30 @@ func (o Option[T]) FlatMap(f func(T) Option[T]) Option[T] {
 	if !o.valid {
 		return o
 	}
-	return f(*o.value)
+	return f(o.value)
 }
 
 // Match pattern-matches on the Option, applying one of two functions.
 func (o Option[T]) Match(some func(T) T, none func() T) T {
 	if o.valid {
-		return some(*o.value)
+		return some(o.value)
 	}
 	return none()
 }
 
+// MergeOptions combines two Options: if both hold a value the result is merge(a, b),
+// if only one does it is returned as is, and if neither does the result is None.
+func MergeOptions[T any](a, b Option[T], merge func(T, T) T) Option[T] {
+	switch {
+	case a.valid && b.valid:
+		return Some(merge(a.value, b.value))
+	case a.valid:
+		return a
+	default:
+		return b
+	}
+}
+
 // ToResult converts an Option to a Result.
 // If the Option contains a value, Ok is returned.
 // If the Option does not contain a value, Err is returned with the provided error.
@@ -120,7 +212,7 @@ func (o Option[T]) ToResult(err error) Result[T] {
 	if !o.valid {
 		return Err[T](err)
 	}
-	return Ok(*o.value)
+	return Ok(o.value)
 }
 
 // MarshalJSON implements the json.Marshaler interface.
@@ -128,7 +220,7 @@ func (o Option[T]) MarshalJSON() ([]byte, error) {
 	if !o.valid {
 		return []byte("null"), nil
 	}
-	return json.Marshal(o.value)
+	return json.Marshal(&o.value)
 }
 
 // UnmarshalJSON implements the json.Unmarshaler interface.
@@ -152,5 +244,5 @@ func (o Option[T]) String() string {
 	if !o.valid {
 		return "None"
 	}
-	return fmt.Sprintf("Some(%v)", *o.value)
+	return fmt.Sprintf("Some(%v)", o.value)
 }
@@ -102,6 +102,23 @@ func TestOption(t *testing.T) {
 		t.Errorf("Expected ToPtr on Some to return non-nil pointer to value")
 	}
 
+	// Test MapInPlace and UnwrapRef
+	inPlace := Some(42)
+	inPlace.MapInPlace(func(v *int) { *v++ })
+	*inPlace.UnwrapRef() *= 2
+	if inPlace.Unwrap() != 86 {
+		t.Errorf("Expected in-place updates to give 86, got %v", inPlace)
+	}
+	n.MapInPlace(func(v *int) { t.Errorf("Expected MapInPlace not to be called on None") })
+	func() {
+		defer func() {
+			if recover() == nil {
+				t.Errorf("Expected UnwrapRef on None to panic")
+			}
+		}()
+		n.UnwrapRef()
+	}()
+
 	// Test String
 	if s.String() != "Some(42)" {
 		t.Errorf("Expected s.String() to be 'Some(42)', got '%s'", s.String())
@@ -150,4 +167,99 @@ func TestOptionJSON(t *testing.T) {
 	if !opt.IsNone() {
 		t.Errorf("Expected unmarshaled value to be None")
 	}
+
+	// Test omitzero
+	type profile struct {
+		Name  string         `json:"name"`
+		Email Option[string] `json:"email,omitzero"`
+		Age   Option[int]    `json:"age,omitzero"`
+	}
+	bytes, err = json.Marshal(profile{Name: "Jane", Age: Some(0)})
+	if err != nil {
+		t.Fatalf("Failed to marshal struct: %v", err)
+	}
+	if string(bytes) != `{"name":"Jane","age":0}` {
+		t.Errorf("Expected None field to be omitted, got '%s'", string(bytes))
+	}
+}
+
+// Test MergeOptions
+func TestMergeOptions(t *testing.T) {
+	sum := func(a, b int) int { return a + b }
+
+	if got := MergeOptions(Some(2), Some(3), sum); got.UnwrapOr(0) != 5 {
+		t.Errorf("Expected Some(5), got %v", got)
+	}
+	if got := MergeOptions(Some(2), None[int](), sum); got.UnwrapOr(0) != 2 {
+		t.Errorf("Expected Some(2), got %v", got)
+	}
+	if got := MergeOptions(None[int](), Some(3), sum); got.UnwrapOr(0) != 3 {
+		t.Errorf("Expected Some(3), got %v", got)
+	}
+	if got := MergeOptions(None[int](), None[int](), sum); got.IsSome() {
+		t.Errorf("Expected None, got %v", got)
+	}
+
+	// Test merge receives the arguments in order
+	last := func(a, b string) string { return b }
+	if got := MergeOptions(Some("base"), Some("override"), last); got.UnwrapOr("") != "override" {
+		t.Errorf("Expected Some(\"override\"), got %v", got)
+	}
+}
+
+// Test NonNil
+func TestNonNil(t *testing.T) {
+	var nilPtr *int
+	var nilMap map[string]int
+	var nilSlice []int
+	var nilFunc func()
+	var nilErr error
+	var typedNil error = (*FieldError)(nil)
+
+	if NonNil(nilPtr).IsSome() || NonNil(nilMap).IsSome() || NonNil(nilSlice).IsSome() || NonNil(nilFunc).IsSome() {
+		t.Errorf("Expected nil pointers, maps, slices and funcs to be None")
+	}
+	if NonNil(nilErr).IsSome() {
+		t.Errorf("Expected a nil interface to be None")
+	}
+	if NonNil(typedNil).IsSome() {
+		t.Errorf("Expected a typed nil inside an interface to be None")
+	}
+
+	n := 1
+	if o := NonNil(&n); o.IsNone() || *o.Unwrap() != 1 {
+		t.Errorf("Expected Some for a non-nil pointer, got %v", o)
+	}
+	if NonNil([]int{}).IsNone() || NonNil(0).IsNone() || NonNil("").IsNone() {
+		t.Errorf("Expected empty slices and zero values to be Some")
+	}
+	var err error = &FieldError{Err: ErrNoValue}
+	if NonNil(err).IsNone() {
+		t.Errorf("Expected a non-nil error to be Some")
+	}
+}
+
+// Test NotEmptyString, NotZero and NotEmptySlice
+func TestNotEmpty(t *testing.T) {
+	if NotEmptyString("").IsSome() {
+		t.Errorf("Expected an empty string to be None")
+	}
+	if o := NotEmptyString("user@example.com"); o.UnwrapOr("") != "user@example.com" {
+		t.Errorf("Expected Some(user@example.com), got %v", o)
+	}
+
+	type point struct{ X, Y int }
+	if NotZero(0).IsSome() || NotZero(point{}).IsSome() {
+		t.Errorf("Expected zero values to be None")
+	}
+	if o := NotZero(point{1, 0}); o.UnwrapOr(point{}) != (point{1, 0}) {
+		t.Errorf("Expected Some({1 0}), got %v", o)
+	}
+
+	if NotEmptySlice([]int(nil)).IsSome() || NotEmptySlice([]int{}).IsSome() {
+		t.Errorf("Expected nil and empty slices to be None")
+	}
+	if o := NotEmptySlice([]int{1}); len(o.UnwrapOr(nil)) != 1 {
+		t.Errorf("Expected Some([1]), got %v", o)
+	}
 }
@@ -0,0 +1,90 @@
+package jagain
+
+import (
+	"fmt"
+	"io"
+	"path/filepath"
+	"runtime"
+	"strconv"
+	"strings"
+	"unsafe"
+)
+
+// Origin returns the file:line at which the Option was created by Some, None or a function
+// built on them. Origins are only recorded in binaries built with the jagaindebug build tag;
+// otherwise, and for zero-value Options, Origin returns an empty string.
+func (o Option[T]) Origin() string {
+	return o.originValue().String()
+}
+
+// Origin returns the file:line at which the Result was created by Ok, Err or a function
+// built on them. Origins are only recorded in binaries built with the jagaindebug build tag;
+// otherwise, and for zero-value Results, Origin returns an empty string.
+func (r Result[T]) Origin() string {
+	return r.originValue().String()
+}
+
+// Format implements the fmt.Formatter interface.
+// The %+v verb prints the Option together with its origin in debug builds; other verbs print String.
+func (o Option[T]) Format(f fmt.State, verb rune) {
+	switch {
+	case verb == 'v' && f.Flag('+'):
+		io.WriteString(f, o.String())
+		writeOrigin(f, o.originValue())
+	case verb == 'q':
+		io.WriteString(f, strconv.Quote(o.String()))
+	default:
+		io.WriteString(f, o.String())
+	}
+}
+
+// The origin is kept in a blank first field of Option and Result so that == and map keys
+// ignore it: Some(1) created on two different lines is still equal. Blank fields cannot be
+// named, so it is reached through a pointer to the start of the struct.
+
+func (o Option[T]) withOrigin(org origin) Option[T] {
+	*(*origin)(unsafe.Pointer(&o)) = org
+	return o
+}
+
+func (o Option[T]) originValue() origin {
+	return *(*origin)(unsafe.Pointer(&o))
+}
+
+func (r Result[T]) withOrigin(org origin) Result[T] {
+	*(*origin)(unsafe.Pointer(&r)) = org
+	return r
+}
+
+func (r Result[T]) originValue() origin {
+	return *(*origin)(unsafe.Pointer(&r))
+}
+
+func writeOrigin(w io.Writer, o origin) {
+	if s := o.String(); s != "" {
+		fmt.Fprintf(w, " (created at %s)", s)
+	}
+}
+
+// packageDir is the directory of this package's source, used to skip internal frames.
+var packageDir = func() string {
+	_, file, _, _ := runtime.Caller(0)
+	return filepath.Dir(file)
+}()
+
+// callerOutsidePackage returns the first frame above skip that is not in the package's
+// non-test source, so that values created by combinators point at the user's code.
+func callerOutsidePackage(skip int) (runtime.Frame, bool) {
+	pcs := make([]uintptr, 32)
+	n := runtime.Callers(skip+2, pcs)
+	frames := runtime.CallersFrames(pcs[:n])
+	for {
+		frame, more := frames.Next()
+		if filepath.Dir(frame.File) != packageDir || strings.HasSuffix(frame.File, "_test.go") {
+			return frame, frame.File != ""
+		}
+		if !more {
+			return runtime.Frame{}, false
+		}
+	}
+}
@@ -0,0 +1,33 @@
+//go:build jagaindebug
+
+package jagain
+
+import "strconv"
+
+// origin records where a value was created. In debug builds it points at the file and
+// line; it is a one-element array rather than a struct so that reflection-based encoders
+// that inspect every field do not trip over it.
+type origin [1]*originInfo
+
+// debugBuild reports whether creation sites are recorded.
+const debugBuild = true
+
+type originInfo struct {
+	file string
+	line int
+}
+
+func newOrigin() origin {
+	frame, ok := callerOutsidePackage(1)
+	if !ok {
+		return origin{}
+	}
+	return origin{{file: frame.File, line: frame.Line}}
+}
+
+func (o origin) String() string {
+	if o[0] == nil {
+		return ""
+	}
+	return o[0].file + ":" + strconv.Itoa(o[0].line)
+}
@@ -0,0 +1,65 @@
+//go:build jagaindebug
+
+package jagain
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+)
+
+func findUser(id int) Option[string] {
+	if id == 1 {
+		return Some("jane")
+	}
+	return None[string]()
+}
+
+func TestOriginDebug(t *testing.T) {
+	// Test that constructors record the caller
+	o := findUser(2)
+	if !strings.Contains(o.Origin(), "origin_debug_test.go:") {
+		t.Errorf("Expected origin in the test file, got %q", o.Origin())
+	}
+
+	// Test that combinators point at the calling code rather than the package
+	r := o.ToResult(ErrNoValue)
+	if !strings.Contains(r.Origin(), "origin_debug_test.go:") {
+		t.Errorf("Expected origin in the test file, got %q", r.Origin())
+	}
+
+	// Test verbose formatting
+	if s := fmt.Sprintf("%+v", o); !strings.HasPrefix(s, "None (created at ") {
+		t.Errorf("Expected %%+v to print the origin, got %q", s)
+	}
+	if s := fmt.Sprintf("%v", o); s != "None" {
+		t.Errorf("Expected %%v to print only the Option, got %q", s)
+	}
+	var zero Option[int]
+	if zero.Origin() != "" {
+		t.Errorf("Expected zero value to have no origin, got %q", zero.Origin())
+	}
+}
+
+func TestOriginDebugEquality(t *testing.T) {
+	// Test that values created on different lines still compare equal
+	a := Some(1)
+	b := Some(1)
+	if a.Origin() == b.Origin() {
+		t.Fatalf("Expected distinct origins, got %q twice", a.Origin())
+	}
+	if a != b {
+		t.Errorf("Expected Some(1) == Some(1) regardless of origin")
+	}
+	if Ok("x") != Ok("x") || None[int]() != None[int]() {
+		t.Errorf("Expected equal Results and Nones regardless of origin")
+	}
+
+	// Test that the origin does not split map keys
+	seen := map[Option[int]]int{}
+	seen[a]++
+	seen[b]++
+	if len(seen) != 1 || seen[Some(1)] != 2 {
+		t.Errorf("Expected one map key counted twice, got %v", seen)
+	}
+}
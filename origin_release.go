@@ -0,0 +1,19 @@
+//go:build !jagaindebug
+
+package jagain
+
+// origin records where a value was created. Outside debug builds it is empty and takes
+// no space, so recording it costs nothing. It is an array rather than a struct so that
+// reflection-based encoders that inspect every field treat it as empty.
+type origin [0]byte
+
+// debugBuild reports whether creation sites are recorded.
+const debugBuild = false
+
+func newOrigin() origin {
+	return origin{}
+}
+
+func (origin) String() string {
+	return ""
+}
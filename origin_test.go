@@ -0,0 +1,22 @@
+//go:build !jagaindebug
+
+package jagain
+
+import (
+	"fmt"
+	"testing"
+	"unsafe"
+)
+
+func TestOriginRelease(t *testing.T) {
+	// Test that origins are not recorded and take no space
+	if Some(1).Origin() != "" || Err[int](ErrNoValue).Origin() != "" {
+		t.Errorf("Expected no origin outside debug builds")
+	}
+	if unsafe.Sizeof(origin{}) != 0 {
+		t.Errorf("Expected origin to take no space")
+	}
+	if s := fmt.Sprintf("%+v", Some(1)); s != "Some(1)" {
+		t.Errorf("Expected %%+v to print only the Option, got %q", s)
+	}
+}
@@ -0,0 +1,69 @@
+package jagain
+
+import (
+	"context"
+	"iter"
+)
+
+// Page is one page of results from a paginated API, with the cursor of the next page,
+// or None on the last page.
+type Page[T, C any] struct {
+	Items []T
+	Next  Option[C]
+}
+
+// Paginator walks a cursor-paginated API, fetching pages until the cursor runs out.
+type Paginator[T, C any] struct {
+	fetch func(context.Context, Option[C]) Result[Page[T, C]]
+}
+
+// NewPaginator creates a Paginator that fetches pages with fetch. The first call gets a
+// None cursor; each later call gets the Next cursor of the previous page.
+func NewPaginator[T, C any](fetch func(ctx context.Context, cursor Option[C]) Result[Page[T, C]]) *Paginator[T, C] {
+	return &Paginator[T, C]{fetch: fetch}
+}
+
+// Pages returns an iterator over the pages. A fetch error, or ctx being done before the
+// next page is fetched, is yielded as a final Err.
+func (p *Paginator[T, C]) Pages(ctx context.Context) iter.Seq[Result[Page[T, C]]] {
+	return func(yield func(Result[Page[T, C]]) bool) {
+		cursor := None[C]()
+		for {
+			if err := ctx.Err(); err != nil {
+				yield(Err[Page[T, C]](err))
+				return
+			}
+			page := p.fetch(ctx, cursor)
+			if !yield(page) || !page.valid {
+				return
+			}
+			cursor = page.value.Next
+			if !cursor.valid {
+				return
+			}
+		}
+	}
+}
+
+// Items returns an iterator over the items of every page, fetching pages as they are
+// needed. Errors are yielded as in Pages.
+func (p *Paginator[T, C]) Items(ctx context.Context) iter.Seq[Result[T]] {
+	return func(yield func(Result[T]) bool) {
+		for page := range p.Pages(ctx) {
+			if !page.valid {
+				yield(errResult[T](page.err))
+				return
+			}
+			for _, item := range page.value.Items {
+				if !yield(Ok(item)) {
+					return
+				}
+			}
+		}
+	}
+}
+
+// All fetches every page and returns their items, or the first error.
+func (p *Paginator[T, C]) All(ctx context.Context) Result[[]T] {
+	return Collect(p.Items(ctx))
+}
@@ -0,0 +1,85 @@
+package jagain
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"testing"
+)
+
+// Test helper serving items in pages of two, with the next offset as the cursor
+func pagesOf(items []int, fail Option[int]) func(context.Context, Option[int]) Result[Page[int, int]] {
+	return func(ctx context.Context, cursor Option[int]) Result[Page[int, int]] {
+		start := cursor.UnwrapOr(0)
+		if fail.IsSome() && start == fail.Unwrap() {
+			return Err[Page[int, int]](errors.New("unavailable"))
+		}
+		end := min(start+2, len(items))
+		page := Page[int, int]{Items: items[start:end]}
+		if end < len(items) {
+			page.Next = Some(end)
+		}
+		return Ok(page)
+	}
+}
+
+func TestPaginator(t *testing.T) {
+	items := []int{1, 2, 3, 4, 5}
+	p := NewPaginator(pagesOf(items, None[int]()))
+
+	// Test All follows cursors until None
+	if got := p.All(context.Background()); !slices.Equal(got.UnwrapOr(nil), items) {
+		t.Errorf("Expected %v, got %v", items, got)
+	}
+
+	// Test pages
+	var sizes []int
+	for page := range p.Pages(context.Background()) {
+		sizes = append(sizes, len(page.UnwrapOr(Page[int, int]{}).Items))
+	}
+	if !slices.Equal(sizes, []int{2, 2, 1}) {
+		t.Errorf("Expected page sizes [2 2 1], got %v", sizes)
+	}
+
+	// Test stopping early fetches no more pages
+	fetched := 0
+	p = NewPaginator(func(ctx context.Context, cursor Option[int]) Result[Page[int, int]] {
+		fetched++
+		return pagesOf(items, None[int]())(ctx, cursor)
+	})
+	for item := range p.Items(context.Background()) {
+		if item.UnwrapOr(0) == 2 {
+			break
+		}
+	}
+	if fetched != 1 {
+		t.Errorf("Expected one page to be fetched, got %d", fetched)
+	}
+}
+
+func TestPaginatorErrors(t *testing.T) {
+	items := []int{1, 2, 3, 4, 5}
+
+	// Test a fetch error ends the iteration
+	p := NewPaginator(pagesOf(items, Some(2)))
+	var got []Result[int]
+	for item := range p.Items(context.Background()) {
+		got = append(got, item)
+	}
+	if len(got) != 3 || !got[2].IsErr() {
+		t.Errorf("Expected two items and an Err, got %v", got)
+	}
+	if r := p.All(context.Background()); !r.IsErr() || r.UnwrapErr().Error() != "unavailable" {
+		t.Errorf("Expected the fetch error, got %v", r)
+	}
+
+	// Test a canceled context stops before the next fetch
+	ctx, cancel := context.WithCancel(context.Background())
+	p = NewPaginator(func(c context.Context, cursor Option[int]) Result[Page[int, int]] {
+		cancel()
+		return pagesOf(items, None[int]())(c, cursor)
+	})
+	if r := p.All(ctx); !errors.Is(r.UnwrapErr(), context.Canceled) {
+		t.Errorf("Expected context.Canceled, got %v", r)
+	}
+}
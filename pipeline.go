@@ -0,0 +1,190 @@
+package jagain
+
+import (
+	"context"
+	"iter"
+	"sync"
+)
+
+// PipelineOption configures a Pipeline.
+type PipelineOption func(*pipelineConfig)
+
+type pipelineConfig struct {
+	buffer     int
+	dropErrors bool
+	deadLetter chan<- error
+	abort      bool
+}
+
+// PipelineBuffer sets the capacity of the channels between stages. A full channel blocks
+// the stage feeding it, so slow stages apply backpressure to earlier ones. The default of
+// zero hands each item over directly.
+func PipelineBuffer(n int) PipelineOption {
+	return func(c *pipelineConfig) {
+		c.buffer = max(n, 0)
+	}
+}
+
+// DropErrors makes a Pipeline discard items for which a stage returns an Err.
+// By default they are passed on unchanged through the remaining stages to the output.
+func DropErrors() PipelineOption {
+	return func(c *pipelineConfig) {
+		c.dropErrors = true
+	}
+}
+
+// DeadLetter makes a Pipeline send the error of every item for which a stage returns an Err
+// to ch instead of passing it on. Sends block like any other stage output, so ch must be
+// drained while the pipeline runs.
+func DeadLetter(ch chan<- error) PipelineOption {
+	return func(c *pipelineConfig) {
+		c.deadLetter = ch
+	}
+}
+
+// AbortOnError makes a Pipeline stop at the first item for which a stage returns an Err,
+// canceling every stage and reporting that error as its final result.
+func AbortOnError() PipelineOption {
+	return func(c *pipelineConfig) {
+		c.abort = true
+	}
+}
+
+// StageOption configures a stage added with Stage.
+type StageOption func(*stageConfig)
+
+type stageConfig struct {
+	workers int
+}
+
+// StageWorkers runs a stage on n goroutines. Items leave a stage with more than one worker
+// in the order they finish, not the order they arrived. A non-positive n is treated as one.
+func StageWorkers(n int) StageOption {
+	return func(c *stageConfig) {
+		c.workers = max(n, 1)
+	}
+}
+
+// Pipeline is a chain of stages running on their own goroutines and connected by bounded
+// channels. It is built with NewPipeline and Stage and consumed with Results or Collect,
+// and it runs until its input is exhausted, its context is done, or it is aborted.
+type Pipeline[T any] struct {
+	run *pipelineRun
+	out <-chan Result[T]
+}
+
+// pipelineRun is the state shared by every stage of a Pipeline.
+type pipelineRun struct {
+	ctx    context.Context
+	cancel context.CancelCauseFunc
+	cfg    pipelineConfig
+}
+
+// NewPipeline starts a Pipeline that feeds the items of seq to its first stage.
+func NewPipeline[T any](ctx context.Context, seq iter.Seq[T], opts ...PipelineOption) *Pipeline[T] {
+	run := &pipelineRun{}
+	for _, opt := range opts {
+		opt(&run.cfg)
+	}
+	run.ctx, run.cancel = context.WithCancelCause(ctx)
+
+	out := make(chan Result[T], run.cfg.buffer)
+	go func() {
+		defer close(out)
+		for item := range seq {
+			if !pipelineSend(run, out, Ok(item)) {
+				return
+			}
+		}
+	}()
+	return &Pipeline[T]{run: run, out: out}
+}
+
+// Stage adds a stage to p that applies f to each item. Err items from earlier stages are
+// passed on without calling f. A panic in f is treated as an Err holding a *PanicError.
+func Stage[T, U any](p *Pipeline[T], f func(context.Context, T) Result[U], opts ...StageOption) *Pipeline[U] {
+	cfg := stageConfig{workers: 1}
+	for _, opt := range opts {
+		opt(&cfg)
+	}
+
+	run := p.run
+	out := make(chan Result[U], run.cfg.buffer)
+	var wg sync.WaitGroup
+	wg.Add(cfg.workers)
+	for range cfg.workers {
+		go func() {
+			defer wg.Done()
+			for item := range p.out {
+				var r Result[U]
+				if item.valid {
+					r = Recovered(func() Result[U] { return f(run.ctx, item.value) })
+				} else {
+					r = errResult[U](item.err)
+				}
+				if !r.valid && item.valid && !run.handleErr(r.err) {
+					continue
+				}
+				if !pipelineSend(run, out, r) {
+					return
+				}
+			}
+		}()
+	}
+	go func() {
+		wg.Wait()
+		close(out)
+	}()
+	return &Pipeline[U]{run: run, out: out}
+}
+
+// handleErr applies the error policy to a new Err and reports whether it should be passed on.
+func (run *pipelineRun) handleErr(err error) bool {
+	switch {
+	case run.cfg.abort:
+		run.cancel(err)
+		return false
+	case run.cfg.deadLetter != nil:
+		select {
+		case run.cfg.deadLetter <- err:
+		case <-run.ctx.Done():
+		}
+		return false
+	case run.cfg.dropErrors:
+		return false
+	}
+	return true
+}
+
+// pipelineSend delivers r to out, reporting false if the pipeline was stopped first.
+func pipelineSend[T any](run *pipelineRun, out chan<- Result[T], r Result[T]) bool {
+	select {
+	case out <- r:
+		return true
+	case <-run.ctx.Done():
+		return false
+	}
+}
+
+// Results returns an iterator over the pipeline's output. If the pipeline was aborted or
+// its context is done, the cause is yielded as a final Err. Stopping the iteration early
+// stops the pipeline.
+func (p *Pipeline[T]) Results() iter.Seq[Result[T]] {
+	return func(yield func(Result[T]) bool) {
+		defer p.run.cancel(nil)
+		for r := range p.out {
+			if !yield(r) {
+				return
+			}
+		}
+		if err := context.Cause(p.run.ctx); err != nil {
+			yield(Err[T](err))
+		}
+	}
+}
+
+// Collect gathers the pipeline's output into a slice, stopping the pipeline at the first
+// Err and returning it.
+func (p *Pipeline[T]) Collect() Result[[]T] {
+	return Collect(p.Results())
+}
@@ -0,0 +1,151 @@
+package jagain
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"strconv"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func parseStage(ctx context.Context, s string) Result[int] {
+	n, err := strconv.Atoi(s)
+	if err != nil {
+		return Err[int](fmt.Errorf("bad item %q", s))
+	}
+	return Ok(n)
+}
+
+func squareStage(ctx context.Context, n int) Result[int] {
+	return Ok(n * n)
+}
+
+func TestPipeline(t *testing.T) {
+	ctx := context.Background()
+	input := slices.Values([]string{"1", "2", "x", "4"})
+
+	// Test stages run in order and Err items pass through by default
+	p := Stage(Stage(NewPipeline(ctx, input), parseStage), squareStage)
+	var got []string
+	for r := range p.Results() {
+		got = append(got, r.String())
+	}
+	if fmt.Sprint(got) != `[Ok(1) Ok(4) Err(bad item "x") Ok(16)]` {
+		t.Errorf("Unexpected results %v", got)
+	}
+
+	// Test DropErrors
+	r := Stage(Stage(NewPipeline(ctx, input, DropErrors()), parseStage), squareStage).Collect()
+	if !slices.Equal(r.UnwrapOr(nil), []int{1, 4, 16}) {
+		t.Errorf("Expected [1 4 16], got %v", r)
+	}
+
+	// Test parallel stages process every item
+	var active, peak atomic.Int32
+	slow := func(ctx context.Context, n int) Result[int] {
+		peak.Store(max(peak.Load(), active.Add(1)))
+		time.Sleep(10 * time.Millisecond)
+		active.Add(-1)
+		return Ok(n)
+	}
+	numbers := slices.Values([]int{1, 2, 3, 4, 5, 6, 7, 8})
+	r = Stage(NewPipeline(ctx, numbers, PipelineBuffer(4)), slow, StageWorkers(4)).Collect()
+	values := r.UnwrapOr(nil)
+	slices.Sort(values)
+	if !slices.Equal(values, []int{1, 2, 3, 4, 5, 6, 7, 8}) {
+		t.Errorf("Expected every item, got %v", r)
+	}
+	if peak.Load() < 2 {
+		t.Errorf("Expected the stage to run items concurrently, peak was %d", peak.Load())
+	}
+}
+
+func TestPipelineErrorPolicies(t *testing.T) {
+	ctx := context.Background()
+	input := slices.Values([]string{"1", "x", "3", "y"})
+
+	// Test DeadLetter
+	dead := make(chan error, 4)
+	r := Stage(NewPipeline(ctx, input, DeadLetter(dead)), parseStage).Collect()
+	close(dead)
+	if !slices.Equal(r.UnwrapOr(nil), []int{1, 3}) {
+		t.Errorf("Expected [1 3], got %v", r)
+	}
+	var deadErrs []string
+	for err := range dead {
+		deadErrs = append(deadErrs, err.Error())
+	}
+	if fmt.Sprint(deadErrs) != `[bad item "x" bad item "y"]` {
+		t.Errorf("Unexpected dead letters %v", deadErrs)
+	}
+
+	// Test AbortOnError stops every stage and reports the error
+	var squared atomic.Int32
+	counting := func(ctx context.Context, n int) Result[int] {
+		squared.Add(1)
+		return Ok(n * n)
+	}
+	endless := func(yield func(string) bool) {
+		for i := 0; ; i++ {
+			item := strconv.Itoa(i)
+			if i == 3 {
+				item = "x"
+			}
+			if !yield(item) {
+				return
+			}
+		}
+	}
+	r = Stage(Stage(NewPipeline(ctx, endless, AbortOnError()), parseStage), counting).Collect()
+	if !r.IsErr() || r.UnwrapErr().Error() != `bad item "x"` {
+		t.Errorf("Expected the aborting error, got %v", r)
+	}
+	if squared.Load() > 3 {
+		t.Errorf("Expected no items after the error, got %d", squared.Load())
+	}
+}
+
+func TestPipelineCancel(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	endless := func(yield func(int) bool) {
+		for i := 0; yield(i); i++ {
+		}
+	}
+
+	// Test a done context ends the pipeline with its error
+	p := Stage(NewPipeline(ctx, endless), squareStage)
+	var last Result[int]
+	count := 0
+	for r := range p.Results() {
+		last = r
+		if count++; count == 5 {
+			cancel()
+		}
+	}
+	if !errors.Is(last.UnwrapErr(), context.Canceled) {
+		t.Errorf("Expected context.Canceled, got %v", last)
+	}
+
+	// Test breaking out of Results stops the pipeline
+	p = Stage(NewPipeline(context.Background(), endless), squareStage)
+	for range p.Results() {
+		break
+	}
+	select {
+	case <-p.run.ctx.Done():
+	case <-time.After(time.Second):
+		t.Errorf("Expected the pipeline to be stopped")
+	}
+
+	// Test panics become Err items
+	boom := func(ctx context.Context, n int) Result[int] { panic("boom") }
+	r := Stage(NewPipeline(context.Background(), slices.Values([]int{1})), boom).Collect()
+	var panicErr *PanicError
+	if !errors.As(r.UnwrapErr(), &panicErr) {
+		t.Errorf("Expected a PanicError, got %v", r)
+	}
+}
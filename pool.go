@@ -0,0 +1,127 @@
+package jagain
+
+import (
+	"errors"
+	"sync"
+)
+
+// ErrPoolClosed is returned when submitting a job to a Pool that has been shut down.
+var ErrPoolClosed = errors.New("pool is closed")
+
+type poolJob[A, B any] struct {
+	input A
+	slot  chan Result[B]
+}
+
+// Pool runs jobs on a fixed number of workers and delivers their Results in submission order.
+// Results must be drained by the caller, otherwise Submit eventually blocks.
+type Pool[A, B any] struct {
+	work    func(A) Result[B]
+	jobs    chan poolJob[A, B]
+	order   chan chan Result[B]
+	results chan Result[B]
+	done    chan struct{}
+	wg      sync.WaitGroup
+	mu      sync.Mutex
+	closed  bool
+
+	// submitting counts Submit calls in progress, which must finish before jobs and
+	// order can be closed.
+	submitting sync.WaitGroup
+	closeOnce  sync.Once
+}
+
+// NewPool starts a Pool with the given number of workers, each applying f to submitted jobs.
+// A non-positive worker count is treated as one.
+func NewPool[A, B any](workers int, f func(A) Result[B]) *Pool[A, B] {
+	if workers <= 0 {
+		workers = 1
+	}
+	p := &Pool[A, B]{
+		work:    f,
+		jobs:    make(chan poolJob[A, B]),
+		order:   make(chan chan Result[B], workers),
+		results: make(chan Result[B]),
+		done:    make(chan struct{}),
+	}
+
+	p.wg.Add(workers)
+	for i := 0; i < workers; i++ {
+		go p.worker()
+	}
+	go p.collect()
+
+	return p
+}
+
+// Submit queues a job for execution.
+// It returns ErrPoolClosed if the Pool has been shut down, including when Shutdown is
+// called while Submit is blocked because Results are not being drained. A job for which
+// Submit returns nil always has its Result delivered, even if Shutdown is called meanwhile.
+func (p *Pool[A, B]) Submit(input A) error {
+	p.mu.Lock()
+	if p.closed {
+		p.mu.Unlock()
+		return ErrPoolClosed
+	}
+	p.submitting.Add(1)
+	p.mu.Unlock()
+	defer p.submitting.Done()
+
+	slot := make(chan Result[B], 1)
+	select {
+	case p.order <- slot:
+	case <-p.done:
+		return ErrPoolClosed
+	}
+	// The slot is queued for delivery, so the job is accepted. Workers never block on a
+	// slot and keep taking jobs until Shutdown closes jobs after this Submit returns, so
+	// the send completes even if the Pool is shutting down.
+	p.jobs <- poolJob[A, B]{input: input, slot: slot}
+	return nil
+}
+
+// Results returns the channel on which job Results are delivered in submission order.
+// The channel is closed once the Pool has been shut down and every Result has been delivered.
+func (p *Pool[A, B]) Results() <-chan Result[B] {
+	return p.results
+}
+
+// Shutdown stops accepting new jobs and waits for the in-flight ones to finish.
+// Calling Shutdown more than once is safe.
+func (p *Pool[A, B]) Shutdown() {
+	p.mu.Lock()
+	if !p.closed {
+		p.closed = true
+		close(p.done)
+	}
+	p.mu.Unlock()
+
+	p.submitting.Wait()
+	p.closeOnce.Do(func() {
+		close(p.jobs)
+		close(p.order)
+	})
+	p.wg.Wait()
+}
+
+func (p *Pool[A, B]) worker() {
+	defer p.wg.Done()
+	for job := range p.jobs {
+		job.slot <- p.run(job.input)
+	}
+}
+
+// run applies the Pool's function, converting a panic into an Err result.
+func (p *Pool[A, B]) run(input A) Result[B] {
+	return Recovered(func() Result[B] {
+		return p.work(input)
+	})
+}
+
+func (p *Pool[A, B]) collect() {
+	defer close(p.results)
+	for slot := range p.order {
+		p.results <- <-slot
+	}
+}
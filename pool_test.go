@@ -0,0 +1,132 @@
+package jagain
+
+import (
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestPool(t *testing.T) {
+	pool := NewPool(4, func(i int) Result[int] {
+		// Finish later jobs first to exercise ordering
+		time.Sleep(time.Duration(10-i) * time.Millisecond)
+		if i == 3 {
+			return Err[int](errors.New("three"))
+		}
+		if i == 5 {
+			panic("five")
+		}
+		return Ok(i * 10)
+	})
+
+	go func() {
+		for i := 0; i < 10; i++ {
+			if err := pool.Submit(i); err != nil {
+				t.Errorf("Expected Submit to succeed, got %v", err)
+			}
+		}
+		pool.Shutdown()
+	}()
+
+	var results []Result[int]
+	for r := range pool.Results() {
+		results = append(results, r)
+	}
+
+	if len(results) != 10 {
+		t.Fatalf("Expected 10 results, got %d", len(results))
+	}
+	for i, r := range results {
+		switch i {
+		case 3:
+			if !r.IsErr() || r.UnwrapErr().Error() != "three" {
+				t.Errorf("Expected result 3 to be Err(three), got %v", r)
+			}
+		case 5:
+			var panicErr *PanicError
+			if !r.IsErr() || !errors.As(r.UnwrapErr(), &panicErr) || panicErr.Value != "five" {
+				t.Errorf("Expected result 5 to be a recovered panic, got %v", r)
+			}
+		default:
+			if !r.IsOk() || r.Unwrap() != i*10 {
+				t.Errorf("Expected result %d to be Ok(%d), got %v", i, i*10, r)
+			}
+		}
+	}
+
+	// Test Submit after Shutdown
+	if err := pool.Submit(1); !errors.Is(err, ErrPoolClosed) {
+		t.Errorf("Expected ErrPoolClosed after Shutdown, got %v", err)
+	}
+	pool.Shutdown()
+}
+
+func TestPoolShutdownUndrained(t *testing.T) {
+	pool := NewPool(1, func(i int) Result[int] { return Ok(i) })
+
+	// Nobody drains Results, so Submit eventually blocks
+	submitted := make(chan error, 1)
+	go func() {
+		for i := 0; ; i++ {
+			if err := pool.Submit(i); err != nil {
+				submitted <- err
+				return
+			}
+		}
+	}()
+	time.Sleep(10 * time.Millisecond)
+
+	shutdown := make(chan struct{})
+	go func() {
+		pool.Shutdown()
+		close(shutdown)
+	}()
+	select {
+	case <-shutdown:
+	case <-time.After(time.Second):
+		t.Fatalf("Expected Shutdown to return while Submit is blocked")
+	}
+	if err := <-submitted; !errors.Is(err, ErrPoolClosed) {
+		t.Errorf("Expected the blocked Submit to return ErrPoolClosed, got %v", err)
+	}
+}
+
+func TestPoolShutdownWhileSubmitting(t *testing.T) {
+	release := make(chan struct{})
+	pool := NewPool(1, func(i int) Result[int] {
+		<-release
+		return Ok(i)
+	})
+	results := make(chan []Result[int])
+	go func() {
+		var all []Result[int]
+		for r := range pool.Results() {
+			all = append(all, r)
+		}
+		results <- all
+	}()
+
+	// The only worker is busy, so the second Submit waits to hand over its job
+	if err := pool.Submit(0); err != nil {
+		t.Fatalf("Failed to submit: %v", err)
+	}
+	submitted := make(chan error, 1)
+	go func() { submitted <- pool.Submit(1) }()
+	time.Sleep(10 * time.Millisecond)
+
+	// Test that a job already accepted is reported on Results only
+	shutdown := make(chan struct{})
+	go func() {
+		pool.Shutdown()
+		close(shutdown)
+	}()
+	time.Sleep(10 * time.Millisecond)
+	close(release)
+	<-shutdown
+	if err := <-submitted; err != nil {
+		t.Errorf("Expected the accepted job to be submitted, got %v", err)
+	}
+	if all := <-results; len(all) != 2 || all[1].UnwrapOr(-1) != 1 {
+		t.Errorf("Expected Ok(0) and Ok(1), got %v", all)
+	}
+}
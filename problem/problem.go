@@ -0,0 +1,128 @@
+// Package problem renders jagain errors as RFC 7807 Problem Details documents.
+package problem
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+
+	"github.com/dendianugerah/jagain"
+)
+
+// ContentType is the media type of a Problem Details document.
+const ContentType = "application/problem+json"
+
+// Details is an RFC 7807 Problem Details document.
+// Extensions are written as additional top-level members.
+type Details struct {
+	Type       string
+	Title      string
+	Status     int
+	Detail     string
+	Instance   string
+	Extensions map[string]any
+}
+
+// Problem lets an error describe its own Problem Details.
+// FromError starts from the document returned by the first error in the chain that
+// implements it.
+type Problem interface {
+	ProblemDetails() Details
+}
+
+// FromError builds Problem Details for err. The status comes from jagain.HTTPStatus and
+// the title from the status text. Metadata attached with jagain.WithFields becomes
+// extensions, an error code attached with jagain.WithCode is reported in the "code"
+// extension, and jagain.FieldError values in the error tree are listed in the "errors"
+// extension as rendered by jagain.ValidationErrors.
+// The detail is the error text only for 4xx statuses, errors with a code and errors that
+// implement Problem, as in jagain.EncodeJSONError; the detail of a recovered panic is
+// never exposed.
+func FromError(err error) Details {
+	var d Details
+	var p Problem
+	isProblem := errors.As(err, &p)
+	if isProblem {
+		d = p.ProblemDetails()
+	}
+
+	if d.Status == 0 {
+		d.Status = jagain.HTTPStatus(err)
+	}
+	if d.Type == "" {
+		d.Type = "about:blank"
+	}
+	if d.Title == "" {
+		d.Title = http.StatusText(d.Status)
+	}
+	var panicErr *jagain.PanicError
+	exposed := d.Status < 500 || isProblem || jagain.ErrorCode(err).IsSome()
+	if d.Detail == "" && err != nil && exposed && !errors.As(err, &panicErr) {
+		d.Detail = err.Error()
+	}
+
+	for k, v := range jagain.ErrorFields(err) {
+		if d.Extensions == nil {
+			d.Extensions = map[string]any{}
+		}
+		if _, ok := d.Extensions[k]; !ok {
+			d.Extensions[k] = v
+		}
+	}
+	if code := jagain.ErrorCode(err); code.IsSome() {
+		if d.Extensions == nil {
+			d.Extensions = map[string]any{}
+		}
+		d.Extensions["code"] = code.Unwrap()
+	}
+	var fieldErr *jagain.FieldError
+	if errors.As(err, &fieldErr) {
+		if d.Extensions == nil {
+			d.Extensions = map[string]any{}
+		}
+		d.Extensions["errors"] = jagain.ValidationErrorsOf(err)
+	}
+	return d
+}
+
+// Encode writes err as a Problem Details document. It has the jagain.ErrorEncoder
+// signature, so it can be installed with jagain.DefaultErrorEncoder = problem.Encode.
+// The request path is used as the instance when the error does not provide one.
+func Encode(w http.ResponseWriter, r *http.Request, err error) {
+	d := FromError(err)
+	if d.Instance == "" && r != nil && r.URL != nil {
+		d.Instance = r.URL.Path
+	}
+	Write(w, d)
+}
+
+// Write writes d with the Problem Details content type and d.Status.
+func Write(w http.ResponseWriter, d Details) {
+	body, err := json.Marshal(d)
+	if err != nil {
+		body, _ = json.Marshal(Details{Type: "about:blank", Title: http.StatusText(http.StatusInternalServerError), Status: http.StatusInternalServerError})
+		d.Status = http.StatusInternalServerError
+	}
+	w.Header().Set("Content-Type", ContentType)
+	w.WriteHeader(d.Status)
+	w.Write(append(body, '\n'))
+}
+
+// MarshalJSON implements the json.Marshaler interface.
+// Standard members take precedence over extensions with the same name.
+func (d Details) MarshalJSON() ([]byte, error) {
+	m := make(map[string]any, len(d.Extensions)+5)
+	for k, v := range d.Extensions {
+		m[k] = v
+	}
+	m["type"] = d.Type
+	m["title"] = d.Title
+	m["status"] = d.Status
+	if d.Detail != "" {
+		m["detail"] = d.Detail
+	}
+	if d.Instance != "" {
+		m["instance"] = d.Instance
+	}
+	return json.Marshal(m)
+}
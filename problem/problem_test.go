@@ -0,0 +1,85 @@
+package problem
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/dendianugerah/jagain"
+)
+
+type quotaError struct{}
+
+func (quotaError) Error() string { return "quota exceeded" }
+func (quotaError) ProblemDetails() Details {
+	return Details{
+		Type:       "https://example.com/problems/quota",
+		Status:     http.StatusTooManyRequests,
+		Extensions: map[string]any{"limit": 100},
+	}
+}
+
+func TestEncode(t *testing.T) {
+	decode := func(rec *httptest.ResponseRecorder) map[string]any {
+		t.Helper()
+		if ct := rec.Header().Get("Content-Type"); ct != ContentType {
+			t.Errorf("Expected content type %s, got %s", ContentType, ct)
+		}
+		var doc map[string]any
+		if err := json.Unmarshal(rec.Body.Bytes(), &doc); err != nil {
+			t.Fatalf("Failed to decode problem: %v", err)
+		}
+		return doc
+	}
+
+	// Test a validation error through the HTTP adapter
+	h := jagain.HandlerWith(func(*http.Request) jagain.Result[int] {
+		return jagain.Err[int](errors.Join(
+			&jagain.FieldError{Path: "email", Err: errors.New("is required")},
+			&jagain.FieldError{Path: "age", Err: errors.New("must be positive")},
+		))
+	}, Encode)
+	rec := httptest.NewRecorder()
+	h.ServeHTTP(rec, httptest.NewRequest(http.MethodPost, "/users", nil))
+	doc := decode(rec)
+	if rec.Code != http.StatusBadRequest || doc["status"] != float64(400) || doc["title"] != "Bad Request" || doc["instance"] != "/users" {
+		t.Errorf("Unexpected problem document: %d %v", rec.Code, doc)
+	}
+	if fields, ok := doc["errors"].([]any); !ok || len(fields) != 2 || fields[1].(map[string]any)["path"] != "age" || fields[1].(map[string]any)["message"] != "must be positive" {
+		t.Errorf("Expected field errors extension, got %v", doc["errors"])
+	}
+
+	// Test an error that describes its own problem
+	rec = httptest.NewRecorder()
+	Encode(rec, httptest.NewRequest(http.MethodGet, "/", nil), quotaError{})
+	doc = decode(rec)
+	if rec.Code != http.StatusTooManyRequests || doc["type"] != "https://example.com/problems/quota" || doc["limit"] != float64(100) || doc["detail"] != "quota exceeded" {
+		t.Errorf("Unexpected problem document: %d %v", rec.Code, doc)
+	}
+
+	// Test error codes and metadata
+	rec = httptest.NewRecorder()
+	Encode(rec, httptest.NewRequest(http.MethodGet, "/", nil), jagain.WithCode("USER_NOT_FOUND", jagain.WithFields(jagain.ErrNoValue, "userID", 42)))
+	doc = decode(rec)
+	if rec.Code != http.StatusNotFound || doc["code"] != "USER_NOT_FOUND" || doc["userID"] != float64(42) {
+		t.Errorf("Expected code and field extensions, got %d %v", rec.Code, doc)
+	}
+
+	// Test that panic details are hidden
+	rec = httptest.NewRecorder()
+	Encode(rec, httptest.NewRequest(http.MethodGet, "/", nil), &jagain.PanicError{Value: "secret"})
+	doc = decode(rec)
+	if rec.Code != http.StatusInternalServerError || doc["detail"] != nil {
+		t.Errorf("Expected panic detail to be hidden, got %v", doc)
+	}
+
+	// Test that the text of a server error is hidden
+	rec = httptest.NewRecorder()
+	Encode(rec, httptest.NewRequest(http.MethodGet, "/", nil), errors.New("pq: connection refused"))
+	doc = decode(rec)
+	if rec.Code != http.StatusInternalServerError || doc["detail"] != nil {
+		t.Errorf("Expected 500 detail to be hidden, got %v", doc)
+	}
+}
@@ -0,0 +1,54 @@
+package jagain
+
+import (
+	"encoding"
+	"fmt"
+	"net/url"
+	"reflect"
+)
+
+// DecodeQuery decodes URL query or form values into a T. Parameters are matched to struct
+// fields by their `query` tag, or by the field name when the tag is absent, and fields
+// tagged `query:"-"` are ignored. A parameter that is absent leaves its field untouched,
+// so Option fields stay None; Option fields given an empty value are None as well. Slice
+// fields receive every value of a repeated parameter. Every conversion failure is reported
+// in the returned ValidationErrors.
+func DecodeQuery[T any](values url.Values) Result[T] {
+	var item T
+	v := reflect.ValueOf(&item).Elem()
+	if v.Kind() != reflect.Struct {
+		return Err[T](fmt.Errorf("decode query: %s is not a struct", v.Type()))
+	}
+
+	var errs ValidationErrors
+	for _, f := range taggedFields(v.Type(), "query", nil, nil) {
+		params, ok := values[f.name]
+		if !ok || len(params) == 0 {
+			continue
+		}
+		if err := decodeParam(v.FieldByIndex(f.index), params); err != nil {
+			errs = append(errs, &FieldError{Path: f.name, Err: err})
+		}
+	}
+	if len(errs) > 0 {
+		return Err[T](errs)
+	}
+	return Ok(item)
+}
+
+// decodeParam stores params in field, using every value for slices and the first otherwise.
+func decodeParam(field reflect.Value, params []string) error {
+	_, isText := field.Addr().Interface().(encoding.TextUnmarshaler)
+	if field.Kind() != reflect.Slice || isText {
+		return unmarshalText([]byte(params[0]), field.Addr().Interface())
+	}
+
+	slice := reflect.MakeSlice(field.Type(), len(params), len(params))
+	for i, param := range params {
+		if err := unmarshalText([]byte(param), slice.Index(i).Addr().Interface()); err != nil {
+			return err
+		}
+	}
+	field.Set(slice)
+	return nil
+}
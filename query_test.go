@@ -0,0 +1,45 @@
+package jagain
+
+import (
+	"net/url"
+	"strings"
+	"testing"
+)
+
+type searchQuery struct {
+	Term  string         `query:"q"`
+	Page  Option[int]    `query:"page"`
+	Limit Option[int]    `query:"limit"`
+	Sort  Option[string] `query:"sort"`
+	Tags  []string       `query:"tag"`
+}
+
+func TestDecodeQuery(t *testing.T) {
+	// Test decoding present, empty and absent parameters
+	values, _ := url.ParseQuery("q=shoes&page=2&sort=&tag=red&tag=sale")
+	query := DecodeQuery[searchQuery](values)
+	if query.IsErr() {
+		t.Fatalf("Failed to decode query: %v", query.UnwrapErr())
+	}
+	q := query.Unwrap()
+	if q.Term != "shoes" || q.Page.UnwrapOr(0) != 2 {
+		t.Errorf("Expected term and page to decode, got %+v", q)
+	}
+	if !q.Limit.IsNone() || !q.Sort.IsNone() {
+		t.Errorf("Expected absent and empty parameters to be None, got %v and %v", q.Limit, q.Sort)
+	}
+	if len(q.Tags) != 2 || q.Tags[1] != "sale" {
+		t.Errorf("Expected repeated parameter to fill slice, got %v", q.Tags)
+	}
+
+	// Test aggregated errors
+	values, _ = url.ParseQuery("page=x&limit=-y")
+	bad := DecodeQuery[searchQuery](values)
+	if !bad.IsErr() {
+		t.Fatalf("Expected invalid parameters to fail")
+	}
+	msg := bad.UnwrapErr().Error()
+	if !strings.Contains(msg, "page: ") || !strings.Contains(msg, "limit: ") {
+		t.Errorf("Expected both invalid parameters to be reported, got %q", msg)
+	}
+}
@@ -0,0 +1,67 @@
+package jagain
+
+import (
+	"fmt"
+	"net/http"
+	"runtime/debug"
+)
+
+// PanicError is the error carried by an Err result produced from a recovered panic.
+type PanicError struct {
+	Value any
+	Stack []byte
+}
+
+// Error implements the error interface.
+func (e *PanicError) Error() string {
+	return fmt.Sprintf("panic: %v", e.Value)
+}
+
+// Unwrap returns the panic value if it is an error, so errors.Is and errors.As can inspect it.
+func (e *PanicError) Unwrap() error {
+	if err, ok := e.Value.(error); ok {
+		return err
+	}
+	return nil
+}
+
+// Recovered calls fn and returns its Result, converting a panic into an Err holding a
+// *PanicError with the stack of the panicking goroutine.
+func Recovered[T any](fn func() Result[T]) (result Result[T]) {
+	defer func() {
+		if v := recover(); v != nil {
+			result = Err[T](&PanicError{Value: v, Stack: debug.Stack()})
+		}
+	}()
+	return fn()
+}
+
+// Recover is HTTP middleware that converts a panic in next into a *PanicError and renders
+// it with DefaultErrorEncoder. Panics with http.ErrAbortHandler are passed through so the
+// server can abort the response as intended.
+func Recover(next http.Handler) http.Handler {
+	return RecoverWith(next, nil)
+}
+
+// RecoverWith is like Recover but renders the error with enc.
+// A nil enc uses DefaultErrorEncoder at the time of each request.
+func RecoverWith(next http.Handler, enc ErrorEncoder) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		defer func() {
+			v := recover()
+			if v == nil {
+				return
+			}
+			if v == http.ErrAbortHandler {
+				panic(v)
+			}
+
+			encode := enc
+			if encode == nil {
+				encode = DefaultErrorEncoder
+			}
+			encode(w, r, &PanicError{Value: v, Stack: debug.Stack()})
+		}()
+		next.ServeHTTP(w, r)
+	})
+}
@@ -0,0 +1,62 @@
+package jagain
+
+import (
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func TestRecovered(t *testing.T) {
+	// Test a normal call
+	if r := Recovered(func() Result[int] { return Ok(1) }); r.UnwrapOr(0) != 1 {
+		t.Errorf("Expected Ok(1), got %v", r)
+	}
+
+	// Test a panic with an error value
+	r := Recovered(func() Result[int] { panic(io.ErrUnexpectedEOF) })
+	var panicErr *PanicError
+	if !errors.As(r.UnwrapErr(), &panicErr) {
+		t.Fatalf("Expected a PanicError, got %v", r)
+	}
+	if !errors.Is(r.UnwrapErr(), io.ErrUnexpectedEOF) {
+		t.Errorf("Expected PanicError to unwrap to the panic value")
+	}
+	if !strings.Contains(string(panicErr.Stack), "TestRecovered") {
+		t.Errorf("Expected stack to include the panicking function, got:\n%s", panicErr.Stack)
+	}
+}
+
+func TestRecover(t *testing.T) {
+	h := Recover(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		panic("boom")
+	}))
+	rec := httptest.NewRecorder()
+	h.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/", nil))
+	if rec.Code != http.StatusInternalServerError || strings.TrimSpace(rec.Body.String()) != `{"error":"Internal Server Error"}` {
+		t.Errorf("Expected panic to render as a 500, got %d %s", rec.Code, rec.Body.String())
+	}
+
+	// Test that the panic value is hidden even when it is a client error
+	h = Recover(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		panic(WithCode("SECRET", teapotError{}))
+	}))
+	rec = httptest.NewRecorder()
+	h.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/", nil))
+	if strings.Contains(rec.Body.String(), "short and stout") {
+		t.Errorf("Expected the panic value to be hidden, got %d %s", rec.Code, rec.Body.String())
+	}
+
+	// Test that aborted handlers keep panicking
+	abort := Recover(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		panic(http.ErrAbortHandler)
+	}))
+	defer func() {
+		if v := recover(); v != http.ErrAbortHandler {
+			t.Errorf("Expected ErrAbortHandler to be re-panicked, got %v", v)
+		}
+	}()
+	abort.ServeHTTP(httptest.NewRecorder(), httptest.NewRequest(http.MethodGet, "/", nil))
+}
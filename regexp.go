@@ -0,0 +1,45 @@
+package jagain
+
+import "regexp"
+
+// FindMatch returns the leftmost match of re in s, or None if there is no match.
+// Unlike re.FindString, an empty match is Some("") rather than indistinguishable from
+// no match.
+func FindMatch(re *regexp.Regexp, s string) Option[string] {
+	loc := re.FindStringIndex(s)
+	if loc == nil {
+		return None[string]()
+	}
+	return Some(s[loc[0]:loc[1]])
+}
+
+// FindNamedGroups returns the named capture groups of the leftmost match of re in s,
+// keyed by group name, or None if there is no match. Groups that did not take part in
+// the match are left out of the map.
+func FindNamedGroups(re *regexp.Regexp, s string) Option[map[string]string] {
+	loc := re.FindStringSubmatchIndex(s)
+	if loc == nil {
+		return None[map[string]string]()
+	}
+	groups := make(map[string]string)
+	for i, name := range re.SubexpNames() {
+		if name != "" && loc[2*i] >= 0 {
+			groups[name] = s[loc[2*i]:loc[2*i+1]]
+		}
+	}
+	return Some(groups)
+}
+
+// CaptureAt returns capture group i of the leftmost match of re in s, where group 0 is
+// the whole match. It is None if there is no match, if i is out of range, or if the group
+// did not take part in the match.
+func CaptureAt(re *regexp.Regexp, s string, i int) Option[string] {
+	if i < 0 || i > re.NumSubexp() {
+		return None[string]()
+	}
+	loc := re.FindStringSubmatchIndex(s)
+	if loc == nil || loc[2*i] < 0 {
+		return None[string]()
+	}
+	return Some(s[loc[2*i]:loc[2*i+1]])
+}
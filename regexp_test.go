@@ -0,0 +1,41 @@
+package jagain
+
+import (
+	"regexp"
+	"testing"
+)
+
+func TestRegexpHelpers(t *testing.T) {
+	// Test FindMatch distinguishes an empty match from no match
+	if got := FindMatch(regexp.MustCompile(`x*`), "abc"); got.UnwrapOr("none") != "" {
+		t.Errorf("Expected Some(\"\"), got %v", got)
+	}
+	if got := FindMatch(regexp.MustCompile(`\d+`), "abc"); !got.IsNone() {
+		t.Errorf("Expected None, got %v", got)
+	}
+
+	// Test FindNamedGroups
+	re := regexp.MustCompile(`(?P<key>\w+)=(?P<value>\w*)(?P<flag>!)?`)
+	groups := FindNamedGroups(re, "set a= now")
+	if groups.IsNone() {
+		t.Fatalf("Expected a match")
+	}
+	g := groups.Unwrap()
+	if g["key"] != "a" || g["value"] != "" || len(g) != 2 {
+		t.Errorf("Expected key=a, an empty value and no flag, got %v", g)
+	}
+	if !FindNamedGroups(re, "nothing here").IsNone() {
+		t.Errorf("Expected None without a match")
+	}
+
+	// Test CaptureAt
+	if got := CaptureAt(re, "x=1!", 3); got.UnwrapOr("") != "!" {
+		t.Errorf("Expected Some(\"!\"), got %v", got)
+	}
+	if got := CaptureAt(re, "x=1", 0); got.UnwrapOr("") != "x=1" {
+		t.Errorf("Expected the whole match, got %v", got)
+	}
+	if !CaptureAt(re, "x=1", 3).IsNone() || !CaptureAt(re, "x=1", 9).IsNone() || !CaptureAt(re, "", 1).IsNone() {
+		t.Errorf("Expected None for unmatched groups, bad indexes and no match")
+	}
+}
@@ -6,8 +6,10 @@ import (
 
 // Result represents either a success value or an error.
 // It's similar to Rust's Result type.
+// Like Option, the value is stored inline, so creating and copying Results does not allocate.
 type Result[T any] struct {
-	value *T
+	_     origin
+	value T
 	err   error
 	valid bool
 }
@@ -15,19 +17,29 @@ type Result[T any] struct {
 // Ok creates a Result containing a success value.
 func Ok[T any](value T) Result[T] {
 	return Result[T]{
-		value: &value,
+		value: value,
 		err:   nil,
 		valid: true,
-	}
+	}.withOrigin(newOrigin())
 }
 
 // Err creates a Result containing an error.
+// When stack capture is enabled with CaptureStacks, the error records the caller's stack.
 func Err[T any](err error) Result[T] {
+	if captureStacks.Load() {
+		err = withStack(err)
+	}
+	errCreatedHooks.fire(err, 1)
 	return Result[T]{
-		value: nil,
 		err:   err,
 		valid: false,
-	}
+	}.withOrigin(newOrigin())
+}
+
+// errResult creates a Result containing err without capturing a stack or running the
+// OnErrCreated hooks, for combinators that pass on the error of an existing Err.
+func errResult[T any](err error) Result[T] {
+	return Result[T]{err: err}.withOrigin(newOrigin())
 }
 
 // IsOk returns true if the Result contains a success value.
@@ -43,9 +55,10 @@ func (r Result[T]) IsErr() bool {
 // Unwrap returns the contained success value or panics if the Result contains an error.
 func (r Result[T]) Unwrap() T {
 	if !r.valid {
+		unwrapPanicHooks.fire(r.err, 1)
 		panic(fmt.Sprintf("called unwrap on an error result: %v", r.err))
 	}
-	return *r.value
+	return r.value
 }
 
 // UnwrapOr returns the contained success value or the provided default if the Result contains an error.
@@ -53,7 +66,7 @@ func (r Result[T]) UnwrapOr(defaultValue T) T {
 	if !r.valid {
 		return defaultValue
 	}
-	return *r.value
+	return r.value
 }
 
 // UnwrapOrElse returns the contained success value or computes a value from the provided function.
@@ -61,13 +74,14 @@ func (r Result[T]) UnwrapOrElse(f func(error) T) T {
 	if !r.valid {
 		return f(r.err)
 	}
-	return *r.value
+	return r.value
 }
 
 // UnwrapErr returns the contained error or panics if the Result contains a success value.
 func (r Result[T]) UnwrapErr() error {
 	if r.valid {
-		panic("called unwrap_err on an ok result")
+		unwrapPanicHooks.fire(errUnwrapOk, 1)
+		panic(errUnwrapOk.Error())
 	}
 	return r.err
 }
@@ -78,16 +92,35 @@ func (r Result[T]) Map(f func(T) T) Result[T] {
 	if !r.valid {
 		return r
 	}
-	return Ok(f(*r.value))
+	return Ok(f(r.value))
+}
+
+// MapInPlace modifies the Result's success value through a pointer.
+// Unlike Map, it does not copy the value, which matters for large structs.
+// If the Result contains an error, f is not called.
+func (r *Result[T]) MapInPlace(f func(*T)) {
+	if r.valid {
+		f(&r.value)
+	}
+}
+
+// UnwrapRef returns a pointer to the contained success value or panics if the Result contains an error.
+// The pointer refers to the Result itself, so writes through it change the Result.
+func (r *Result[T]) UnwrapRef() *T {
+	if !r.valid {
+		unwrapPanicHooks.fire(r.err, 1)
+		panic(fmt.Sprintf("called unwrap on an error result: %v", r.err))
+	}
+	return &r.value
 }
 
 // MapTo transforms the Result's success value into a different type using the provided function.
 // If the Result contains an error, an error result of the new type is returned.
 func MapTo[T, U any](r Result[T], f func(T) U) Result[U] {
 	if !r.valid {
-		return Err[U](r.err)
+		return errResult[U](r.err)
 	}
-	return Ok(f(*r.value))
+	return Ok(f(r.value))
 }
 
 // MapErr transforms the Result's error using the provided function.
@@ -96,7 +129,25 @@ func (r Result[T]) MapErr(f func(error) error) Result[T] {
 	if r.valid {
 		return r
 	}
-	return Err[T](f(r.err))
+	return errResult[T](f(r.err))
+}
+
+// Wrap adds context to the Result's error, producing an error of the form "msg: err"
+// that still matches the original with errors.Is and errors.As.
+// If the Result contains a success value, it is returned unchanged.
+func (r Result[T]) Wrap(msg string) Result[T] {
+	if r.valid {
+		return r
+	}
+	return errResult[T](fmt.Errorf("%s: %w", msg, r.err))
+}
+
+// Wrapf is like Wrap but formats the context message according to a format specifier.
+func (r Result[T]) Wrapf(format string, args ...any) Result[T] {
+	if r.valid {
+		return r
+	}
+	return errResult[T](fmt.Errorf("%s: %w", fmt.Sprintf(format, args...), r.err))
 }
 
 // FlatMap transforms the Result's success value into another Result of the same type using the provided function.
@@ -105,22 +156,22 @@ func (r Result[T]) FlatMap(f func(T) Result[T]) Result[T] {
 	if !r.valid {
 		return r
 	}
-	return f(*r.value)
+	return f(r.value)
 }
 
 // FlatMapTo transforms the Result's success value into a Result of a different type.
 // If the Result contains an error, an error result of the new type is returned.
 func FlatMapTo[T, U any](r Result[T], f func(T) Result[U]) Result[U] {
 	if !r.valid {
-		return Err[U](r.err)
+		return errResult[U](r.err)
 	}
-	return f(*r.value)
+	return f(r.value)
 }
 
 // Match pattern-matches on the Result, applying one of two functions.
 func (r Result[T]) Match(ok func(T) T, err func(error) T) T {
 	if r.valid {
-		return ok(*r.value)
+		return ok(r.value)
 	}
 	return err(r.err)
 }
@@ -128,7 +179,7 @@ func (r Result[T]) Match(ok func(T) T, err func(error) T) T {
 // MatchTo pattern-matches on the Result, applying one of two functions that return a different type.
 func MatchTo[T, U any](r Result[T], ok func(T) U, err func(error) U) U {
 	if r.valid {
-		return ok(*r.value)
+		return ok(r.value)
 	}
 	return err(r.err)
 }
@@ -140,7 +191,7 @@ func (r Result[T]) ToOption() Option[T] {
 	if !r.valid {
 		return None[T]()
 	}
-	return Some(*r.value)
+	return Some(r.value)
 }
 
 // String implements the fmt.Stringer interface.
@@ -148,5 +199,5 @@ func (r Result[T]) String() string {
 	if !r.valid {
 		return fmt.Sprintf("Err(%v)", r.err)
 	}
-	return fmt.Sprintf("Ok(%v)", *r.value)
+	return fmt.Sprintf("Ok(%v)", r.value)
 }
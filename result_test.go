@@ -69,6 +69,19 @@ func TestResult(t *testing.T) {
 		t.Errorf("Expected MapErr on Ok to be a no-op")
 	}
 
+	// Test Wrap and Wrapf
+	wrapped := err.Wrap("loading user")
+	if wrapped.UnwrapErr().Error() != "loading user: "+err.UnwrapErr().Error() || !errors.Is(wrapped.UnwrapErr(), err.UnwrapErr()) {
+		t.Errorf("Expected Wrap to add context, got %v", wrapped.UnwrapErr())
+	}
+	wrapped = err.Wrapf("loading user %d", 7)
+	if wrapped.UnwrapErr().Error() != "loading user 7: "+err.UnwrapErr().Error() {
+		t.Errorf("Expected Wrapf to format context, got %v", wrapped.UnwrapErr())
+	}
+	if !ok.Wrap("ignored").IsOk() || ok.Wrapf("ignored %d", 1).Unwrap() != 42 {
+		t.Errorf("Expected Wrap on Ok to be a no-op")
+	}
+
 	// Test FlatMap
 	flatMapped := ok.FlatMap(func(i int) Result[int] {
 		if i > 0 {
@@ -108,6 +121,23 @@ func TestResult(t *testing.T) {
 		t.Errorf("Expected ToOption on Err to return None")
 	}
 
+	// Test MapInPlace and UnwrapRef
+	inPlace := Ok(42)
+	inPlace.MapInPlace(func(v *int) { *v++ })
+	*inPlace.UnwrapRef() *= 2
+	if inPlace.Unwrap() != 86 {
+		t.Errorf("Expected in-place updates to give 86, got %v", inPlace)
+	}
+	err.MapInPlace(func(v *int) { t.Errorf("Expected MapInPlace not to be called on Err") })
+	func() {
+		defer func() {
+			if recover() == nil {
+				t.Errorf("Expected UnwrapRef on Err to panic")
+			}
+		}()
+		err.UnwrapRef()
+	}()
+
 	// Test String
 	if ok.String() != "Ok(42)" {
 		t.Errorf("Expected ok.String() to be 'Ok(42)', got '%s'", ok.String())
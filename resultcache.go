@@ -0,0 +1,127 @@
+package jagain
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+// ResultCacheOption configures a ResultCache.
+type ResultCacheOption func(*resultCacheConfig)
+
+type resultCacheConfig struct {
+	errTTL time.Duration
+	stale  time.Duration
+}
+
+// CacheErrorsFor makes a ResultCache cache Err results for ttl, typically much shorter than
+// the TTL of Ok results, so that a failing key is not reloaded on every call. By default
+// Err results are not cached.
+func CacheErrorsFor(ttl time.Duration) ResultCacheOption {
+	return func(c *resultCacheConfig) {
+		c.errTTL = ttl
+	}
+}
+
+// StaleWhileRevalidate lets a ResultCache keep serving an expired Ok value for up to window
+// after it expires, while a fresh value is loaded in the background.
+func StaleWhileRevalidate(window time.Duration) ResultCacheOption {
+	return func(c *resultCacheConfig) {
+		c.stale = window
+	}
+}
+
+// ResultCache caches the Results of a load function for a limited time.
+// Concurrent loads of the same key are shared, as with SharedLoader.
+// It is safe for concurrent use.
+type ResultCache[K comparable, V any] struct {
+	ttl    time.Duration
+	cfg    resultCacheConfig
+	loader *SharedLoader[K, V]
+
+	mu      sync.Mutex
+	entries map[K]*resultCacheEntry[V]
+}
+
+type resultCacheEntry[V any] struct {
+	result     Result[V]
+	expires    time.Time
+	refreshing bool
+}
+
+// NewResultCache creates a ResultCache that loads values with load and caches Ok results for ttl.
+func NewResultCache[K comparable, V any](ttl time.Duration, load func(context.Context, K) Result[V], opts ...ResultCacheOption) *ResultCache[K, V] {
+	c := &ResultCache[K, V]{
+		ttl:     ttl,
+		loader:  NewSharedLoader(load),
+		entries: make(map[K]*resultCacheEntry[V]),
+	}
+	for _, opt := range opts {
+		opt(&c.cfg)
+	}
+	return c
+}
+
+// Get returns the cached Result for key, loading it if it is missing or expired.
+// Within the stale-while-revalidate window an expired Ok value is returned immediately and
+// refreshed in the background; if the refresh fails, the stale value is kept until the
+// window closes.
+func (c *ResultCache[K, V]) Get(ctx context.Context, key K) Result[V] {
+	now := time.Now()
+	c.mu.Lock()
+	if e, ok := c.entries[key]; ok {
+		if now.Before(e.expires) {
+			c.mu.Unlock()
+			return e.result
+		}
+		if e.result.valid && now.Before(e.expires.Add(c.cfg.stale)) {
+			if !e.refreshing {
+				e.refreshing = true
+				go c.refresh(context.WithoutCancel(ctx), key, e)
+			}
+			c.mu.Unlock()
+			return e.result
+		}
+	}
+	c.mu.Unlock()
+
+	r := c.loader.Load(ctx, key)
+	if ctx.Err() == nil {
+		c.store(key, r)
+	}
+	return r
+}
+
+// refresh reloads an entry served as stale.
+func (c *ResultCache[K, V]) refresh(ctx context.Context, key K, stale *resultCacheEntry[V]) {
+	r := c.loader.Load(ctx, key)
+	if r.valid {
+		c.store(key, r)
+		return
+	}
+	c.mu.Lock()
+	stale.refreshing = false
+	c.mu.Unlock()
+}
+
+// store caches r for key with the TTL that applies to it.
+func (c *ResultCache[K, V]) store(key K, r Result[V]) {
+	ttl := c.ttl
+	if !r.valid {
+		ttl = c.cfg.errTTL
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if ttl <= 0 {
+		delete(c.entries, key)
+		return
+	}
+	c.entries[key] = &resultCacheEntry[V]{result: r, expires: time.Now().Add(ttl)}
+}
+
+// Invalidate removes the cached Result for key, so the next Get loads it again.
+func (c *ResultCache[K, V]) Invalidate(key K) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	delete(c.entries, key)
+}
@@ -0,0 +1,113 @@
+//go:build go1.25
+
+package jagain
+
+import (
+	"context"
+	"errors"
+	"sync/atomic"
+	"testing"
+	"testing/synctest"
+	"time"
+)
+
+func TestResultCache(t *testing.T) {
+	synctest.Test(t, func(t *testing.T) {
+		var calls atomic.Int32
+		c := NewResultCache(time.Minute, func(ctx context.Context, key string) Result[int32] {
+			return Ok(calls.Add(1))
+		})
+		ctx := context.Background()
+
+		// Test values are cached until the TTL passes
+		c.Get(ctx, "a")
+		if r := c.Get(ctx, "a"); r.UnwrapOr(0) != 1 {
+			t.Errorf("Expected the cached Ok(1), got %v", r)
+		}
+		time.Sleep(time.Minute)
+		if r := c.Get(ctx, "a"); r.UnwrapOr(0) != 2 {
+			t.Errorf("Expected a reload after the TTL, got %v", r)
+		}
+
+		// Test Invalidate
+		c.Invalidate("a")
+		if r := c.Get(ctx, "a"); r.UnwrapOr(0) != 3 {
+			t.Errorf("Expected a reload after Invalidate, got %v", r)
+		}
+	})
+}
+
+func TestResultCacheErrors(t *testing.T) {
+	synctest.Test(t, func(t *testing.T) {
+		var calls atomic.Int32
+		load := func(ctx context.Context, key string) Result[int] {
+			calls.Add(1)
+			return Err[int](errors.New("unavailable"))
+		}
+		ctx := context.Background()
+
+		// Test errors are not cached by default
+		c := NewResultCache(time.Minute, load)
+		c.Get(ctx, "a")
+		c.Get(ctx, "a")
+		if calls.Load() != 2 {
+			t.Errorf("Expected two loads, got %d", calls.Load())
+		}
+
+		// Test errors are cached for the negative TTL
+		calls.Store(0)
+		c = NewResultCache(time.Minute, load, CacheErrorsFor(time.Second))
+		c.Get(ctx, "a")
+		if r := c.Get(ctx, "a"); !r.IsErr() || calls.Load() != 1 {
+			t.Errorf("Expected the cached Err, got %v after %d loads", r, calls.Load())
+		}
+		time.Sleep(time.Second)
+		c.Get(ctx, "a")
+		if calls.Load() != 2 {
+			t.Errorf("Expected a reload after the negative TTL, got %d loads", calls.Load())
+		}
+	})
+}
+
+func TestResultCacheStaleWhileRevalidate(t *testing.T) {
+	synctest.Test(t, func(t *testing.T) {
+		var calls atomic.Int32
+		var fail atomic.Bool
+		c := NewResultCache(time.Minute, func(ctx context.Context, key string) Result[int32] {
+			n := calls.Add(1)
+			time.Sleep(time.Second)
+			if fail.Load() {
+				return Err[int32](errors.New("unavailable"))
+			}
+			return Ok(n)
+		}, StaleWhileRevalidate(time.Hour))
+		ctx := context.Background()
+
+		// Test the stale value is served while refreshing in the background
+		c.Get(ctx, "a")
+		time.Sleep(time.Minute)
+		if r := c.Get(ctx, "a"); r.UnwrapOr(0) != 1 {
+			t.Errorf("Expected the stale Ok(1), got %v", r)
+		}
+		c.Get(ctx, "a")
+		time.Sleep(2 * time.Second)
+		if r := c.Get(ctx, "a"); r.UnwrapOr(0) != 2 || calls.Load() != 2 {
+			t.Errorf("Expected the refreshed Ok(2) from one refresh, got %v after %d loads", r, calls.Load())
+		}
+
+		// Test a failed refresh keeps the stale value
+		fail.Store(true)
+		time.Sleep(time.Minute)
+		c.Get(ctx, "a")
+		time.Sleep(2 * time.Second)
+		if r := c.Get(ctx, "a"); r.UnwrapOr(0) != 2 {
+			t.Errorf("Expected the stale Ok(2) after a failed refresh, got %v", r)
+		}
+
+		// Test the stale value is dropped after the window
+		time.Sleep(time.Hour)
+		if r := c.Get(ctx, "a"); !r.IsErr() {
+			t.Errorf("Expected a synchronous load after the window, got %v", r)
+		}
+	})
+}
@@ -0,0 +1,136 @@
+package jagain
+
+import (
+	"context"
+	"fmt"
+	"strings"
+)
+
+// Saga runs a sequence of side-effecting steps, each paired with a compensation that undoes
+// it. If a step fails, the compensations of the steps that already succeeded run in reverse
+// order, so a multi-step workflow either completes or is rolled back as far as possible.
+// Steps that need an earlier step's value can capture it in a variable set by that step.
+type Saga struct {
+	steps []SagaStep
+}
+
+// SagaStep is a named step of a Saga, created with Step.
+type SagaStep struct {
+	name string
+	run  func(context.Context) (undo func(context.Context) error, err error)
+}
+
+// Step pairs an action with the compensation that undoes it. The compensation receives the
+// value the action produced; a nil compensation means the step needs no undoing.
+func Step[T any](name string, action func(context.Context) Result[T], compensate func(context.Context, T) error) SagaStep {
+	return SagaStep{name: name, run: func(ctx context.Context) (func(context.Context) error, error) {
+		r := Recovered(func() Result[T] { return action(ctx) })
+		if !r.valid {
+			return nil, r.err
+		}
+		if compensate == nil {
+			return nil, nil
+		}
+		return func(ctx context.Context) error {
+			return Recovered(func() Result[struct{}] {
+				if err := compensate(ctx, r.value); err != nil {
+					return Err[struct{}](err)
+				}
+				return Ok(struct{}{})
+			}).err
+		}, nil
+	}}
+}
+
+// NewSaga creates a Saga that runs steps in order.
+func NewSaga(steps ...SagaStep) *Saga {
+	return &Saga{steps: steps}
+}
+
+// Add appends a step to the Saga.
+func (s *Saga) Add(step SagaStep) *Saga {
+	s.steps = append(s.steps, step)
+	return s
+}
+
+// Run executes the steps in order and stops at the first failure, which is reported as a
+// *SagaError after the compensations have run. Compensations run with a context that is
+// not canceled along with ctx, since a canceled ctx is often why the saga failed. A panic
+// in a step or compensation is treated as its failure.
+func (s *Saga) Run(ctx context.Context) Result[struct{}] {
+	var done []SagaStep
+	var undos []func(context.Context) error
+	for _, step := range s.steps {
+		undo, err := step.run(ctx)
+		if err != nil {
+			return Err[struct{}](&SagaError{
+				Step:          step.name,
+				Err:           err,
+				Compensations: runCompensations(context.WithoutCancel(ctx), done, undos),
+			})
+		}
+		done = append(done, step)
+		undos = append(undos, undo)
+	}
+	return Ok(struct{}{})
+}
+
+// runCompensations runs the undo functions in reverse order, continuing past failures.
+func runCompensations(ctx context.Context, steps []SagaStep, undos []func(context.Context) error) []*CompensationError {
+	var errs []*CompensationError
+	for i := len(undos) - 1; i >= 0; i-- {
+		if undos[i] == nil {
+			continue
+		}
+		if err := undos[i](ctx); err != nil {
+			errs = append(errs, &CompensationError{Step: steps[i].name, Err: err})
+		}
+	}
+	return errs
+}
+
+// SagaError reports the step at which a Saga failed and any compensations that failed
+// while rolling back. errors.Is and errors.As see the step's error and every compensation
+// error.
+type SagaError struct {
+	Step          string
+	Err           error
+	Compensations []*CompensationError
+}
+
+// Error implements the error interface.
+func (e *SagaError) Error() string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "saga step %s: %v", e.Step, e.Err)
+	for _, c := range e.Compensations {
+		b.WriteString("; ")
+		b.WriteString(c.Error())
+	}
+	return b.String()
+}
+
+// Unwrap returns the step's error followed by the compensation errors.
+func (e *SagaError) Unwrap() []error {
+	errs := []error{e.Err}
+	for _, c := range e.Compensations {
+		errs = append(errs, c)
+	}
+	return errs
+}
+
+// CompensationError reports that the compensation of a Saga step failed, leaving its
+// effect in place.
+type CompensationError struct {
+	Step string
+	Err  error
+}
+
+// Error implements the error interface.
+func (e *CompensationError) Error() string {
+	return fmt.Sprintf("compensating %s: %v", e.Step, e.Err)
+}
+
+// Unwrap returns the underlying error.
+func (e *CompensationError) Unwrap() error {
+	return e.Err
+}
@@ -0,0 +1,102 @@
+package jagain
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"testing"
+)
+
+func TestSaga(t *testing.T) {
+	var log []string
+	step := func(name string, fail error) SagaStep {
+		return Step(name,
+			func(ctx context.Context) Result[string] {
+				if fail != nil {
+					return Err[string](fail)
+				}
+				log = append(log, "do "+name)
+				return Ok(name + "-id")
+			},
+			func(ctx context.Context, id string) error {
+				log = append(log, "undo "+id)
+				return nil
+			})
+	}
+
+	// Test every step runs when none fails
+	r := NewSaga(step("reserve", nil), step("charge", nil)).Run(context.Background())
+	if !r.IsOk() || !slices.Equal(log, []string{"do reserve", "do charge"}) {
+		t.Errorf("Expected both steps to run, got %v (%v)", log, r)
+	}
+
+	// Test compensations run in reverse order after a failure
+	log = nil
+	errDeclined := errors.New("declined")
+	r = NewSaga(step("reserve", nil), step("invoice", nil)).
+		Add(step("charge", errDeclined)).
+		Add(step("ship", nil)).
+		Run(context.Background())
+	want := []string{"do reserve", "do invoice", "undo invoice-id", "undo reserve-id"}
+	if !slices.Equal(log, want) {
+		t.Errorf("Expected %v, got %v", want, log)
+	}
+	var sagaErr *SagaError
+	if !errors.As(r.UnwrapErr(), &sagaErr) || sagaErr.Step != "charge" {
+		t.Fatalf("Expected a SagaError for charge, got %v", r)
+	}
+	if !errors.Is(r.UnwrapErr(), errDeclined) {
+		t.Errorf("Expected the step error to be wrapped")
+	}
+	if r.UnwrapErr().Error() != "saga step charge: declined" {
+		t.Errorf("Expected \"saga step charge: declined\", got %q", r.UnwrapErr().Error())
+	}
+}
+
+func TestSagaCompensationFailure(t *testing.T) {
+	errRefund := errors.New("refund failed")
+	var undone []string
+	ctx, cancel := context.WithCancel(context.Background())
+	r := NewSaga(
+		Step("reserve",
+			func(ctx context.Context) Result[int] { return Ok(1) },
+			func(ctx context.Context, _ int) error {
+				if ctx.Err() != nil {
+					t.Errorf("Expected compensations to run with a live context")
+				}
+				undone = append(undone, "reserve")
+				return nil
+			}),
+		Step("charge",
+			func(ctx context.Context) Result[int] { return Ok(2) },
+			func(ctx context.Context, _ int) error { return errRefund }),
+		Step("notify",
+			func(ctx context.Context) Result[int] { return Ok(3) },
+			nil),
+		Step("ship",
+			func(ctx context.Context) Result[int] { cancel(); panic("boom") },
+			nil),
+	).Run(ctx)
+
+	// Test later compensations still run after one fails
+	if !slices.Equal(undone, []string{"reserve"}) {
+		t.Errorf("Expected reserve to be compensated, got %v", undone)
+	}
+	var sagaErr *SagaError
+	if !errors.As(r.UnwrapErr(), &sagaErr) {
+		t.Fatalf("Expected a SagaError, got %v", r)
+	}
+	var panicErr *PanicError
+	if !errors.As(sagaErr.Err, &panicErr) {
+		t.Errorf("Expected the panic to be the step error, got %v", sagaErr.Err)
+	}
+	if len(sagaErr.Compensations) != 1 || sagaErr.Compensations[0].Step != "charge" {
+		t.Errorf("Expected one failed compensation for charge, got %v", sagaErr.Compensations)
+	}
+	if !errors.Is(r.UnwrapErr(), errRefund) {
+		t.Errorf("Expected the compensation error to be wrapped")
+	}
+	if r.UnwrapErr().Error() != "saga step ship: panic: boom; compensating charge: refund failed" {
+		t.Errorf("Unexpected message %q", r.UnwrapErr().Error())
+	}
+}
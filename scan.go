@@ -0,0 +1,88 @@
+package jagain
+
+import (
+	"database/sql"
+	"fmt"
+	"reflect"
+	"strings"
+)
+
+// ScanStruct reads every remaining row into a slice of T and closes rows.
+// Columns are matched to struct fields by their `db` tag, or by the lowercased field name
+// when the tag is absent; fields tagged `db:"-"` are ignored and embedded structs are
+// searched too. Option fields receive None for NULL columns. A column with no matching
+// field is reported as an error.
+func ScanStruct[T any](rows *sql.Rows) Result[[]T] {
+	defer rows.Close()
+
+	t := reflect.TypeFor[T]()
+	if t.Kind() != reflect.Struct {
+		return Err[[]T](fmt.Errorf("scan struct: %s is not a struct", t))
+	}
+
+	columns, err := rows.Columns()
+	if err != nil {
+		return Err[[]T](err)
+	}
+
+	fields := dbFields(t, nil, map[string][]int{})
+	indexes := make([][]int, len(columns))
+	for i, col := range columns {
+		index, ok := fields[col]
+		if !ok {
+			return Err[[]T](fmt.Errorf("scan struct: no field in %s for column %q", t, col))
+		}
+		indexes[i] = index
+	}
+
+	items := []T{}
+	dest := make([]any, len(columns))
+	for rows.Next() {
+		var item T
+		v := reflect.ValueOf(&item).Elem()
+		for i, index := range indexes {
+			dest[i] = v.FieldByIndex(index).Addr().Interface()
+		}
+		if err := rows.Scan(dest...); err != nil {
+			return Err[[]T](fmt.Errorf("scan struct: %w", err))
+		}
+		items = append(items, item)
+	}
+	if err := rows.Err(); err != nil {
+		return Err[[]T](err)
+	}
+	return Ok(items)
+}
+
+// dbFields maps column names to field indexes for t and its embedded structs.
+// Fields of the outer struct take precedence over those of embedded ones.
+func dbFields(t reflect.Type, prefix []int, fields map[string][]int) map[string][]int {
+	var embedded []reflect.StructField
+	for i := 0; i < t.NumField(); i++ {
+		f := t.Field(i)
+		tag := f.Tag.Get("db")
+		if tag == "-" {
+			continue
+		}
+		if f.Anonymous && tag == "" && f.Type.Kind() == reflect.Struct {
+			embedded = append(embedded, f)
+			continue
+		}
+		if !f.IsExported() {
+			continue
+		}
+
+		name := tag
+		if name == "" {
+			name = strings.ToLower(f.Name)
+		}
+		if _, ok := fields[name]; !ok {
+			fields[name] = append(append([]int{}, prefix...), f.Index...)
+		}
+	}
+
+	for _, f := range embedded {
+		dbFields(f.Type, append(append([]int{}, prefix...), f.Index...), fields)
+	}
+	return fields
+}
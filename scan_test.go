@@ -0,0 +1,120 @@
+package jagain
+
+import (
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"errors"
+	"io"
+	"testing"
+)
+
+// fakeDriver serves a fixed result set for every query.
+type fakeDriver struct {
+	columns []string
+	rows    [][]driver.Value
+}
+
+func (d *fakeDriver) Open(string) (driver.Conn, error) { return &fakeConn{d}, nil }
+
+type fakeConn struct{ d *fakeDriver }
+
+func (c *fakeConn) Prepare(string) (driver.Stmt, error) { return &fakeStmt{c.d}, nil }
+func (c *fakeConn) Close() error                        { return nil }
+func (c *fakeConn) Begin() (driver.Tx, error)           { return nil, errors.New("not supported") }
+
+type fakeStmt struct{ d *fakeDriver }
+
+func (s *fakeStmt) Close() error  { return nil }
+func (s *fakeStmt) NumInput() int { return -1 }
+func (s *fakeStmt) Exec([]driver.Value) (driver.Result, error) {
+	return nil, errors.New("not supported")
+}
+func (s *fakeStmt) Query([]driver.Value) (driver.Rows, error) {
+	return &fakeRows{d: s.d}, nil
+}
+
+type fakeRows struct {
+	d *fakeDriver
+	i int
+}
+
+func (r *fakeRows) Columns() []string { return r.d.columns }
+func (r *fakeRows) Close() error      { return nil }
+func (r *fakeRows) Next(dest []driver.Value) error {
+	if r.i >= len(r.d.rows) {
+		return io.EOF
+	}
+	copy(dest, r.d.rows[r.i])
+	r.i++
+	return nil
+}
+
+// queryFake returns rows for the given fixed result set.
+func queryFake(t *testing.T, columns []string, rows ...[]driver.Value) *sql.Rows {
+	t.Helper()
+	db := sql.OpenDB(connector{&fakeDriver{columns: columns, rows: rows}})
+	t.Cleanup(func() { db.Close() })
+	result, err := db.Query("SELECT")
+	if err != nil {
+		t.Fatalf("Failed to query fake database: %v", err)
+	}
+	return result
+}
+
+type connector struct{ d *fakeDriver }
+
+func (c connector) Connect(context.Context) (driver.Conn, error) { return c.d.Open("") }
+func (c connector) Driver() driver.Driver                        { return c.d }
+
+type audit struct {
+	CreatedBy Option[string] `db:"created_by"`
+}
+
+type scannedUser struct {
+	audit
+	ID       int64          `db:"id"`
+	Name     string         `db:"name"`
+	Email    Option[string] `db:"email"`
+	Age      Option[int64]
+	Internal string `db:"-"`
+}
+
+func TestScanStruct(t *testing.T) {
+	rows := queryFake(t,
+		[]string{"id", "name", "email", "age", "created_by"},
+		[]driver.Value{int64(1), "Jane", "jane@example.com", int64(30), "admin"},
+		[]driver.Value{int64(2), "John", nil, nil, nil},
+	)
+
+	result := ScanStruct[scannedUser](rows)
+	if !result.IsOk() {
+		t.Fatalf("Expected ScanStruct to succeed, got %v", result)
+	}
+	users := result.Unwrap()
+	if len(users) != 2 {
+		t.Fatalf("Expected 2 users, got %d", len(users))
+	}
+
+	if users[0].Name != "Jane" || users[0].Email.UnwrapOr("") != "jane@example.com" || users[0].Age.UnwrapOr(0) != 30 {
+		t.Errorf("Unexpected first user: %+v", users[0])
+	}
+	if users[0].CreatedBy.UnwrapOr("") != "admin" {
+		t.Errorf("Expected embedded field to be scanned, got %v", users[0].CreatedBy)
+	}
+	if !users[1].Email.IsNone() || !users[1].Age.IsNone() || !users[1].CreatedBy.IsNone() {
+		t.Errorf("Expected NULL columns to scan as None: %+v", users[1])
+	}
+
+	// Test unknown columns
+	rows = queryFake(t, []string{"id", "nickname"}, []driver.Value{int64(1), "x"})
+	if r := ScanStruct[scannedUser](rows); !r.IsErr() {
+		t.Errorf("Expected unknown column to fail, got %v", r)
+	}
+
+	// Test non-struct types
+	rows = queryFake(t, []string{"id"})
+	if r := ScanStruct[int](rows); !r.IsErr() {
+		t.Errorf("Expected non-struct type to fail, got %v", r)
+	}
+}
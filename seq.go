@@ -0,0 +1,61 @@
+package jagain
+
+import (
+	"bufio"
+	"encoding/json"
+	"errors"
+	"io"
+	"iter"
+)
+
+// Lines returns an iterator over the lines of r, without their line endings.
+// A read error is yielded as a final Err, so it cannot be overlooked.
+func Lines(r io.Reader) iter.Seq[Result[string]] {
+	return func(yield func(Result[string]) bool) {
+		scanner := bufio.NewScanner(r)
+		for scanner.Scan() {
+			if !yield(Ok(scanner.Text())) {
+				return
+			}
+		}
+		if err := scanner.Err(); err != nil {
+			yield(Err[string](err))
+		}
+	}
+}
+
+// DecodeEach returns an iterator that decodes a stream of JSON values, such as JSON
+// lines, into T. A read or decode error is yielded as a final Err, since the stream
+// cannot be resumed after it.
+func DecodeEach[T any](r io.Reader) iter.Seq[Result[T]] {
+	return func(yield func(Result[T]) bool) {
+		dec := json.NewDecoder(r)
+		for {
+			var value T
+			err := dec.Decode(&value)
+			if errors.Is(err, io.EOF) {
+				return
+			}
+			if err != nil {
+				yield(Err[T](err))
+				return
+			}
+			if !yield(Ok(value)) {
+				return
+			}
+		}
+	}
+}
+
+// Collect gathers the values of seq into a slice, stopping at the first Err and
+// returning it.
+func Collect[T any](seq iter.Seq[Result[T]]) Result[[]T] {
+	var values []T
+	for r := range seq {
+		if !r.valid {
+			return Err[[]T](r.err)
+		}
+		values = append(values, r.value)
+	}
+	return Ok(values)
+}
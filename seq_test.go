@@ -0,0 +1,62 @@
+package jagain
+
+import (
+	"errors"
+	"strings"
+	"testing"
+	"testing/iotest"
+)
+
+func TestLines(t *testing.T) {
+	// Test lines are yielded without endings
+	got := Collect(Lines(strings.NewReader("a\r\nb\n\nc")))
+	if lines := got.UnwrapOr(nil); len(lines) != 4 || lines[0] != "a" || lines[2] != "" || lines[3] != "c" {
+		t.Errorf("Expected [a b  c], got %v", got)
+	}
+
+	// Test read errors are yielded last
+	errRead := errors.New("read failed")
+	var results []Result[string]
+	for r := range Lines(iotest.DataErrReader(iotest.ErrReader(errRead))) {
+		results = append(results, r)
+	}
+	if len(results) != 1 || !errors.Is(results[0].UnwrapErr(), errRead) {
+		t.Errorf("Expected a single Err, got %v", results)
+	}
+
+	// Test stopping early
+	count := 0
+	for range Lines(strings.NewReader("a\nb\nc")) {
+		count++
+		break
+	}
+	if count != 1 {
+		t.Errorf("Expected to stop after one line, got %d", count)
+	}
+}
+
+func TestDecodeEach(t *testing.T) {
+	type event struct {
+		ID int `json:"id"`
+	}
+
+	// Test JSON lines
+	got := Collect(DecodeEach[event](strings.NewReader("{\"id\":1}\n{\"id\":2}\n")))
+	if events := got.UnwrapOr(nil); len(events) != 2 || events[1].ID != 2 {
+		t.Errorf("Expected two events, got %v", got)
+	}
+
+	// Test decode errors end the stream after the valid values
+	var results []Result[event]
+	for r := range DecodeEach[event](strings.NewReader("{\"id\":1}\n{\"id\":\"x\"}\n{\"id\":3}\n")) {
+		results = append(results, r)
+	}
+	if len(results) != 2 || results[0].IsErr() || results[1].IsOk() {
+		t.Errorf("Expected Ok then Err, got %v", results)
+	}
+
+	// Test Collect returns the error
+	if r := Collect(DecodeEach[event](strings.NewReader("{"))); r.IsOk() {
+		t.Errorf("Expected Collect to return the decode error")
+	}
+}
@@ -0,0 +1,81 @@
+package jagain
+
+import (
+	"context"
+	"sync"
+)
+
+// SharedLoader deduplicates concurrent loads of the same key: while a load is in flight,
+// further callers for that key wait for it and receive the same Result instead of
+// starting their own. Results are not cached once the load completes; combine with
+// Memoize or a cache for that.
+type SharedLoader[K comparable, V any] struct {
+	load  func(context.Context, K) Result[V]
+	mu    sync.Mutex
+	calls map[K]*sharedCall[V]
+}
+
+type sharedCall[V any] struct {
+	done    chan struct{}
+	result  Result[V]
+	waiters int
+	cancel  context.CancelFunc
+}
+
+// NewSharedLoader creates a SharedLoader that loads values with load.
+func NewSharedLoader[K comparable, V any](load func(context.Context, K) Result[V]) *SharedLoader[K, V] {
+	return &SharedLoader[K, V]{load: load, calls: make(map[K]*sharedCall[V])}
+}
+
+// Load returns the Result of loading key, joining a load already in flight if there is one.
+//
+// The load runs with a context that carries the first caller's values but not its
+// cancellation. A caller whose ctx is done stops waiting and gets an Err with the context's
+// error; the load itself is canceled only once every waiting caller has given up.
+// A panic in the load function is returned to every waiter as an Err holding a *PanicError.
+func (l *SharedLoader[K, V]) Load(ctx context.Context, key K) Result[V] {
+	l.mu.Lock()
+	c, ok := l.calls[key]
+	if !ok {
+		loadCtx, cancel := context.WithCancel(context.WithoutCancel(ctx))
+		c = &sharedCall[V]{done: make(chan struct{}), cancel: cancel}
+		l.calls[key] = c
+		go l.run(loadCtx, key, c)
+	}
+	c.waiters++
+	l.mu.Unlock()
+
+	select {
+	case <-c.done:
+		return c.result
+	case <-ctx.Done():
+		l.mu.Lock()
+		c.waiters--
+		if c.waiters == 0 {
+			c.cancel()
+			l.forget(key, c)
+		}
+		l.mu.Unlock()
+		return Err[V](ctx.Err())
+	}
+}
+
+func (l *SharedLoader[K, V]) run(ctx context.Context, key K, c *sharedCall[V]) {
+	defer c.cancel()
+	c.result = Recovered(func() Result[V] {
+		return l.load(ctx, key)
+	})
+
+	l.mu.Lock()
+	l.forget(key, c)
+	l.mu.Unlock()
+	close(c.done)
+}
+
+// forget removes c from the in-flight calls, unless a newer call has replaced it.
+// The caller must hold l.mu.
+func (l *SharedLoader[K, V]) forget(key K, c *sharedCall[V]) {
+	if l.calls[key] == c {
+		delete(l.calls, key)
+	}
+}
@@ -0,0 +1,97 @@
+package jagain
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestSharedLoader(t *testing.T) {
+	var calls atomic.Int32
+	release := make(chan struct{})
+	l := NewSharedLoader(func(ctx context.Context, key string) Result[string] {
+		calls.Add(1)
+		select {
+		case <-release:
+			return Ok("value of " + key)
+		case <-ctx.Done():
+			return Err[string](ctx.Err())
+		}
+	})
+
+	// Test concurrent callers share one load
+	var wg sync.WaitGroup
+	results := make([]Result[string], 10)
+	for i := range results {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			results[i] = l.Load(context.Background(), "a")
+		}()
+	}
+	time.Sleep(20 * time.Millisecond)
+	close(release)
+	wg.Wait()
+	if calls.Load() != 1 {
+		t.Errorf("Expected one load, got %d", calls.Load())
+	}
+	for _, r := range results {
+		if r.UnwrapOr("") != "value of a" {
+			t.Errorf("Expected every caller to get the shared value, got %v", r)
+		}
+	}
+
+	// Test results are not cached
+	l.Load(context.Background(), "a")
+	if calls.Load() != 2 {
+		t.Errorf("Expected a new load after completion, got %d loads", calls.Load())
+	}
+}
+
+func TestSharedLoaderCancel(t *testing.T) {
+	canceled := make(chan struct{})
+	l := NewSharedLoader(func(ctx context.Context, key int) Result[int] {
+		<-ctx.Done()
+		close(canceled)
+		return Err[int](ctx.Err())
+	})
+
+	// Test a waiter giving up gets its context error
+	ctx1, cancel1 := context.WithCancel(context.Background())
+	ctx2, cancel2 := context.WithCancel(context.Background())
+	done := make(chan Result[int], 2)
+	go func() { done <- l.Load(ctx1, 1) }()
+	go func() { done <- l.Load(ctx2, 1) }()
+	time.Sleep(20 * time.Millisecond)
+	cancel1()
+	if r := <-done; !errors.Is(r.UnwrapErr(), context.Canceled) {
+		t.Errorf("Expected context.Canceled, got %v", r)
+	}
+
+	// Test the load is canceled only when every waiter has gone
+	select {
+	case <-canceled:
+		t.Fatalf("Expected the load to continue while a waiter remains")
+	case <-time.After(20 * time.Millisecond):
+	}
+	cancel2()
+	<-done
+	select {
+	case <-canceled:
+	case <-time.After(time.Second):
+		t.Errorf("Expected the load to be canceled after the last waiter left")
+	}
+}
+
+func TestSharedLoaderPanic(t *testing.T) {
+	l := NewSharedLoader(func(ctx context.Context, key int) Result[int] {
+		panic("boom")
+	})
+	var panicErr *PanicError
+	if r := l.Load(context.Background(), 1); !errors.As(r.UnwrapErr(), &panicErr) {
+		t.Errorf("Expected a PanicError, got %v", r)
+	}
+}
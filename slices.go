@@ -0,0 +1,46 @@
+package jagain
+
+// SomeAll wraps every value in Some. The Options share a single backing array,
+// so converting a slice costs one allocation rather than one per element.
+func SomeAll[T any](values []T) []Option[T] {
+	o := newOrigin()
+	out := make([]Option[T], len(values))
+	for i, v := range values {
+		out[i] = Option[T]{value: v, valid: true}.withOrigin(o)
+	}
+	return out
+}
+
+// OkAll wraps every value in Ok. The Results share a single backing array,
+// so converting a slice costs one allocation rather than one per element.
+func OkAll[T any](values []T) []Result[T] {
+	o := newOrigin()
+	out := make([]Result[T], len(values))
+	for i, v := range values {
+		out[i] = Result[T]{value: v, valid: true}.withOrigin(o)
+	}
+	return out
+}
+
+// ValuesInto appends the values of the Some Options in opts to dst and returns the
+// extended slice. None Options are skipped. Passing a dst with enough capacity avoids
+// allocating at all.
+func ValuesInto[T any](dst []T, opts []Option[T]) []T {
+	for _, o := range opts {
+		if o.valid {
+			dst = append(dst, o.value)
+		}
+	}
+	return dst
+}
+
+// OkValuesInto appends the success values of the Ok Results in results to dst and
+// returns the extended slice. Err Results are skipped.
+func OkValuesInto[T any](dst []T, results []Result[T]) []T {
+	for _, r := range results {
+		if r.valid {
+			dst = append(dst, r.value)
+		}
+	}
+	return dst
+}
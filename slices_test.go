@@ -0,0 +1,49 @@
+package jagain
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestSlices(t *testing.T) {
+	values := []int{1, 2, 3}
+
+	// Test SomeAll
+	opts := SomeAll(values)
+	if len(opts) != 3 || opts[2].Unwrap() != 3 {
+		t.Errorf("Expected SomeAll to wrap every value, got %v", opts)
+	}
+
+	// Test OkAll
+	results := OkAll(values)
+	if len(results) != 3 || results[0].Unwrap() != 1 {
+		t.Errorf("Expected OkAll to wrap every value, got %v", results)
+	}
+
+	// Test ValuesInto skips None and appends to dst
+	opts = append(opts, None[int](), Some(4))
+	got := ValuesInto([]int{0}, opts)
+	if len(got) != 5 || got[0] != 0 || got[4] != 4 {
+		t.Errorf("Expected [0 1 2 3 4], got %v", got)
+	}
+
+	// Test OkValuesInto skips Err
+	results = append(results, Err[int](errors.New("bad")))
+	got = OkValuesInto(got[:0], results)
+	if len(got) != 3 || got[2] != 3 {
+		t.Errorf("Expected [1 2 3], got %v", got)
+	}
+
+	// Test allocation counts
+	if !debugBuild {
+		var sink []Option[int]
+		if allocs := testing.AllocsPerRun(100, func() { sink = SomeAll(values) }); allocs != 1 {
+			t.Errorf("Expected SomeAll to allocate once, got %v", allocs)
+		}
+		_ = sink
+		dst := make([]int, 0, len(opts))
+		if allocs := testing.AllocsPerRun(100, func() { ValuesInto(dst[:0], opts) }); allocs != 0 {
+			t.Errorf("Expected ValuesInto not to allocate with enough capacity, got %v", allocs)
+		}
+	}
+}
@@ -0,0 +1,38 @@
+package jagain
+
+import (
+	"log/slog"
+	"maps"
+	"slices"
+)
+
+// LogValue implements the slog.LogValuer interface.
+// Some is logged as a group with present=true and the value; None as a group with present=false.
+func (o Option[T]) LogValue() slog.Value {
+	if !o.valid {
+		return slog.GroupValue(slog.Bool("present", false))
+	}
+	return slog.GroupValue(slog.Bool("present", true), slog.Any("value", o.value))
+}
+
+// LogValue implements the slog.LogValuer interface.
+// Ok is logged as a group with ok=true and the value. Err is logged as a group with
+// ok=false, the error message, its code when it has one, and its metadata in key order.
+func (r Result[T]) LogValue() slog.Value {
+	if r.valid {
+		return slog.GroupValue(slog.Bool("ok", true), slog.Any("value", r.value))
+	}
+
+	attrs := []slog.Attr{slog.Bool("ok", false)}
+	if r.err != nil {
+		attrs = append(attrs, slog.String("error", r.err.Error()))
+	}
+	if code := ErrorCode(r.err); code.IsSome() {
+		attrs = append(attrs, slog.String("code", code.Unwrap()))
+	}
+	fields := ErrorFields(r.err)
+	for _, k := range slices.Sorted(maps.Keys(fields)) {
+		attrs = append(attrs, slog.Any(k, fields[k]))
+	}
+	return slog.GroupValue(attrs...)
+}
@@ -0,0 +1,39 @@
+package jagain
+
+import (
+	"bytes"
+	"errors"
+	"log/slog"
+	"strings"
+	"testing"
+)
+
+func TestLogValue(t *testing.T) {
+	var buf bytes.Buffer
+	logger := slog.New(slog.NewTextHandler(&buf, &slog.HandlerOptions{
+		ReplaceAttr: func(groups []string, a slog.Attr) slog.Attr {
+			if len(groups) == 0 && (a.Key == slog.TimeKey || a.Key == slog.LevelKey) {
+				return slog.Attr{}
+			}
+			return a
+		},
+	}))
+
+	tests := []struct {
+		value any
+		want  string
+	}{
+		{Some(42), "msg=x v.present=true v.value=42"},
+		{None[int](), "msg=x v.present=false"},
+		{Ok("done"), "msg=x v.ok=true v.value=done"},
+		{ErrCode[int]("DB_DOWN", WithFields(errors.New("db down"), "table", "users", "attempt", 2)),
+			`msg=x v.ok=false v.error="db down" v.code=DB_DOWN v.attempt=2 v.table=users`},
+	}
+	for _, tt := range tests {
+		buf.Reset()
+		logger.Info("x", "v", tt.value)
+		if got := strings.TrimSpace(buf.String()); got != tt.want {
+			t.Errorf("Expected %q, got %q", tt.want, got)
+		}
+	}
+}
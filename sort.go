@@ -0,0 +1,43 @@
+package jagain
+
+import (
+	"cmp"
+	"slices"
+)
+
+// NoneOrder decides where None sorts relative to values.
+type NoneOrder int
+
+const (
+	// NoneFirst sorts None before every value, as CompareTime does.
+	NoneFirst NoneOrder = iota
+	// NoneLast sorts None after every value.
+	NoneLast
+)
+
+// CompareFunc returns a function comparing two Options, for use with slices.SortFunc and
+// similar. Values are compared with cmp.Compare, None equals None, and None is placed
+// according to order.
+func CompareFunc[T cmp.Ordered](order NoneOrder) func(a, b Option[T]) int {
+	none := -1
+	if order == NoneLast {
+		none = 1
+	}
+	return func(a, b Option[T]) int {
+		switch {
+		case !a.valid && !b.valid:
+			return 0
+		case !a.valid:
+			return none
+		case !b.valid:
+			return -none
+		}
+		return cmp.Compare(a.value, b.value)
+	}
+}
+
+// SortOptions sorts opts in ascending order of their values, placing None according to
+// order. The sort is stable.
+func SortOptions[T cmp.Ordered](opts []Option[T], order NoneOrder) {
+	slices.SortStableFunc(opts, CompareFunc[T](order))
+}
@@ -0,0 +1,37 @@
+package jagain
+
+import (
+	"fmt"
+	"slices"
+	"testing"
+)
+
+func TestSortOptions(t *testing.T) {
+	opts := func() []Option[int] {
+		return []Option[int]{Some(3), None[int](), Some(1), None[int](), Some(2)}
+	}
+
+	// Test NoneFirst
+	s := opts()
+	SortOptions(s, NoneFirst)
+	if fmt.Sprint(s) != "[None None Some(1) Some(2) Some(3)]" {
+		t.Errorf("Unexpected order %v", s)
+	}
+
+	// Test NoneLast
+	s = opts()
+	SortOptions(s, NoneLast)
+	if fmt.Sprint(s) != "[Some(1) Some(2) Some(3) None None]" {
+		t.Errorf("Unexpected order %v", s)
+	}
+
+	// Test CompareFunc with slices.SortFunc
+	names := []Option[string]{Some("bob"), None[string](), Some("ann")}
+	slices.SortFunc(names, CompareFunc[string](NoneLast))
+	if fmt.Sprint(names) != "[Some(ann) Some(bob) None]" {
+		t.Errorf("Unexpected order %v", names)
+	}
+	if CompareFunc[int](NoneFirst)(None[int](), None[int]()) != 0 {
+		t.Errorf("Expected None to equal None")
+	}
+}
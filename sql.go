@@ -0,0 +1,169 @@
+package jagain
+
+import (
+	"database/sql"
+	"database/sql/driver"
+	"time"
+)
+
+// Scan implements the sql.Scanner interface.
+// A NULL column is scanned as None; any other value is converted into T and scanned as Some.
+// Conversion is delegated to sql.Null[T], so Option accepts exactly what sql.Null[T] accepts.
+func (o *Option[T]) Scan(src any) error {
+	var n sql.Null[T]
+	if err := n.Scan(src); err != nil {
+		return err
+	}
+	*o = FromSQLNull(n)
+	return nil
+}
+
+// Value implements the driver.Valuer interface.
+// None is written as NULL; Some is converted the same way sql.Null[T] converts its value.
+func (o Option[T]) Value() (driver.Value, error) {
+	return ToSQLNull(o).Value()
+}
+
+// FromSQLNull converts a sql.Null[T] to an Option.
+func FromSQLNull[T any](n sql.Null[T]) Option[T] {
+	if !n.Valid {
+		return None[T]()
+	}
+	return Some(n.V)
+}
+
+// ToSQLNull converts an Option to a sql.Null[T].
+func ToSQLNull[T any](o Option[T]) sql.Null[T] {
+	if !o.valid {
+		return sql.Null[T]{}
+	}
+	return sql.Null[T]{V: o.value, Valid: true}
+}
+
+// FromNullString converts a sql.NullString to an Option.
+func FromNullString(n sql.NullString) Option[string] {
+	if !n.Valid {
+		return None[string]()
+	}
+	return Some(n.String)
+}
+
+// ToNullString converts an Option to a sql.NullString.
+func ToNullString(o Option[string]) sql.NullString {
+	if !o.valid {
+		return sql.NullString{}
+	}
+	return sql.NullString{String: o.value, Valid: true}
+}
+
+// FromNullInt64 converts a sql.NullInt64 to an Option.
+func FromNullInt64(n sql.NullInt64) Option[int64] {
+	if !n.Valid {
+		return None[int64]()
+	}
+	return Some(n.Int64)
+}
+
+// ToNullInt64 converts an Option to a sql.NullInt64.
+func ToNullInt64(o Option[int64]) sql.NullInt64 {
+	if !o.valid {
+		return sql.NullInt64{}
+	}
+	return sql.NullInt64{Int64: o.value, Valid: true}
+}
+
+// FromNullInt32 converts a sql.NullInt32 to an Option.
+func FromNullInt32(n sql.NullInt32) Option[int32] {
+	if !n.Valid {
+		return None[int32]()
+	}
+	return Some(n.Int32)
+}
+
+// ToNullInt32 converts an Option to a sql.NullInt32.
+func ToNullInt32(o Option[int32]) sql.NullInt32 {
+	if !o.valid {
+		return sql.NullInt32{}
+	}
+	return sql.NullInt32{Int32: o.value, Valid: true}
+}
+
+// FromNullInt16 converts a sql.NullInt16 to an Option.
+func FromNullInt16(n sql.NullInt16) Option[int16] {
+	if !n.Valid {
+		return None[int16]()
+	}
+	return Some(n.Int16)
+}
+
+// ToNullInt16 converts an Option to a sql.NullInt16.
+func ToNullInt16(o Option[int16]) sql.NullInt16 {
+	if !o.valid {
+		return sql.NullInt16{}
+	}
+	return sql.NullInt16{Int16: o.value, Valid: true}
+}
+
+// FromNullByte converts a sql.NullByte to an Option.
+func FromNullByte(n sql.NullByte) Option[byte] {
+	if !n.Valid {
+		return None[byte]()
+	}
+	return Some(n.Byte)
+}
+
+// ToNullByte converts an Option to a sql.NullByte.
+func ToNullByte(o Option[byte]) sql.NullByte {
+	if !o.valid {
+		return sql.NullByte{}
+	}
+	return sql.NullByte{Byte: o.value, Valid: true}
+}
+
+// FromNullFloat64 converts a sql.NullFloat64 to an Option.
+func FromNullFloat64(n sql.NullFloat64) Option[float64] {
+	if !n.Valid {
+		return None[float64]()
+	}
+	return Some(n.Float64)
+}
+
+// ToNullFloat64 converts an Option to a sql.NullFloat64.
+func ToNullFloat64(o Option[float64]) sql.NullFloat64 {
+	if !o.valid {
+		return sql.NullFloat64{}
+	}
+	return sql.NullFloat64{Float64: o.value, Valid: true}
+}
+
+// FromNullBool converts a sql.NullBool to an Option.
+func FromNullBool(n sql.NullBool) Option[bool] {
+	if !n.Valid {
+		return None[bool]()
+	}
+	return Some(n.Bool)
+}
+
+// ToNullBool converts an Option to a sql.NullBool.
+func ToNullBool(o Option[bool]) sql.NullBool {
+	if !o.valid {
+		return sql.NullBool{}
+	}
+	return sql.NullBool{Bool: o.value, Valid: true}
+}
+
+// FromNullTime converts a sql.NullTime to an Option.
+func FromNullTime(n sql.NullTime) Option[time.Time] {
+	if !n.Valid {
+		return None[time.Time]()
+	}
+	return Some(n.Time)
+}
+
+// ToNullTime converts an Option to a sql.NullTime.
+func ToNullTime(o Option[time.Time]) sql.NullTime {
+	if !o.valid {
+		return sql.NullTime{}
+	}
+	return sql.NullTime{Time: o.value, Valid: true}
+}
@@ -0,0 +1,161 @@
+package jagain
+
+import (
+	"database/sql"
+	"database/sql/driver"
+	"testing"
+	"time"
+)
+
+func TestOptionSQL(t *testing.T) {
+	// Test scanning NULL
+	s := Some("stale")
+	if err := s.Scan(nil); err != nil {
+		t.Fatalf("Failed to scan NULL: %v", err)
+	}
+	if !s.IsNone() {
+		t.Errorf("Expected NULL to scan as None")
+	}
+
+	// Test scanning supported types
+	var str Option[string]
+	if err := str.Scan([]byte("hello")); err != nil || str.Unwrap() != "hello" {
+		t.Errorf("Expected []byte to scan into Some(\"hello\"), got %v (%v)", str, err)
+	}
+
+	var i Option[int64]
+	if err := i.Scan(int64(42)); err != nil || i.Unwrap() != 42 {
+		t.Errorf("Expected int64 to scan into Some(42), got %v (%v)", i, err)
+	}
+	if err := i.Scan("17"); err != nil || i.Unwrap() != 17 {
+		t.Errorf("Expected string to scan into Some(17), got %v (%v)", i, err)
+	}
+	if err := i.Scan("abc"); err == nil {
+		t.Errorf("Expected scanning \"abc\" into int64 to fail")
+	}
+
+	var f Option[float64]
+	if err := f.Scan(int64(3)); err != nil || f.Unwrap() != 3 {
+		t.Errorf("Expected int64 to scan into Some(3.0), got %v (%v)", f, err)
+	}
+
+	var b Option[bool]
+	if err := b.Scan(int64(1)); err != nil || !b.Unwrap() {
+		t.Errorf("Expected int64 1 to scan into Some(true), got %v (%v)", b, err)
+	}
+
+	now := time.Now()
+	var tm Option[time.Time]
+	if err := tm.Scan(now); err != nil || !tm.Unwrap().Equal(now) {
+		t.Errorf("Expected time.Time to scan into Some, got %v (%v)", tm, err)
+	}
+
+	raw := []byte("bytes")
+	var bs Option[[]byte]
+	if err := bs.Scan(raw); err != nil || string(bs.Unwrap()) != "bytes" {
+		t.Errorf("Expected []byte to scan into Some, got %v (%v)", bs, err)
+	}
+	raw[0] = 'X'
+	if string(bs.Unwrap()) != "bytes" {
+		t.Errorf("Expected scanned []byte to be copied")
+	}
+
+	// Test scanning through an inner sql.Scanner
+	var ns Option[sql.NullString]
+	if err := ns.Scan("inner"); err != nil || ns.Unwrap().String != "inner" {
+		t.Errorf("Expected inner Scanner to be used, got %v (%v)", ns, err)
+	}
+
+	// Test Value
+	v, err := None[string]().Value()
+	if err != nil || v != nil {
+		t.Errorf("Expected None to be written as NULL, got %v (%v)", v, err)
+	}
+	v, err = Some(7).Value()
+	if err != nil || v != int64(7) {
+		t.Errorf("Expected Some(7) to be written as int64(7), got %v (%v)", v, err)
+	}
+	v, err = Some(sql.NullString{String: "x", Valid: true}).Value()
+	if err != nil || v != "x" {
+		t.Errorf("Expected inner Valuer to be used, got %v (%v)", v, err)
+	}
+
+	var _ sql.Scanner = (*Option[string])(nil)
+	var _ driver.Valuer = Option[string]{}
+}
+
+func TestNullConverters(t *testing.T) {
+	// Test sql.NullString
+	if FromNullString(sql.NullString{}).IsSome() {
+		t.Errorf("Expected invalid NullString to convert to None")
+	}
+	if o := FromNullString(sql.NullString{String: "a", Valid: true}); o.UnwrapOr("") != "a" {
+		t.Errorf("Expected valid NullString to convert to Some(\"a\"), got %v", o)
+	}
+	if n := ToNullString(Some("a")); !n.Valid || n.String != "a" {
+		t.Errorf("Expected Some(\"a\") to convert to a valid NullString, got %v", n)
+	}
+	if n := ToNullString(None[string]()); n.Valid {
+		t.Errorf("Expected None to convert to an invalid NullString")
+	}
+
+	// Test sql.NullInt64
+	if o := FromNullInt64(sql.NullInt64{Int64: 5, Valid: true}); o.UnwrapOr(0) != 5 {
+		t.Errorf("Expected valid NullInt64 to convert to Some(5), got %v", o)
+	}
+	if n := ToNullInt64(None[int64]()); n.Valid {
+		t.Errorf("Expected None to convert to an invalid NullInt64")
+	}
+
+	// Test sql.NullInt32, NullInt16 and NullByte
+	if o := FromNullInt32(sql.NullInt32{Int32: 3, Valid: true}); o.UnwrapOr(0) != 3 {
+		t.Errorf("Expected valid NullInt32 to convert to Some(3), got %v", o)
+	}
+	if n := ToNullInt16(Some[int16](2)); !n.Valid || n.Int16 != 2 {
+		t.Errorf("Expected Some(2) to convert to a valid NullInt16, got %v", n)
+	}
+	if o := FromNullByte(sql.NullByte{}); o.IsSome() {
+		t.Errorf("Expected invalid NullByte to convert to None")
+	}
+
+	// Test sql.NullFloat64 and NullBool
+	if n := ToNullFloat64(Some(1.5)); !n.Valid || n.Float64 != 1.5 {
+		t.Errorf("Expected Some(1.5) to convert to a valid NullFloat64, got %v", n)
+	}
+	if o := FromNullBool(sql.NullBool{Bool: true, Valid: true}); !o.UnwrapOr(false) {
+		t.Errorf("Expected valid NullBool to convert to Some(true), got %v", o)
+	}
+
+	// Test sql.NullTime
+	now := time.Now()
+	if o := FromNullTime(sql.NullTime{Time: now, Valid: true}); !o.Unwrap().Equal(now) {
+		t.Errorf("Expected valid NullTime to convert to Some, got %v", o)
+	}
+	if n := ToNullTime(None[time.Time]()); n.Valid {
+		t.Errorf("Expected None to convert to an invalid NullTime")
+	}
+}
+
+func TestSQLNull(t *testing.T) {
+	// Test FromSQLNull
+	if FromSQLNull(sql.Null[int]{}).IsSome() {
+		t.Errorf("Expected invalid sql.Null to convert to None")
+	}
+	if o := FromSQLNull(sql.Null[int]{V: 9, Valid: true}); o.UnwrapOr(0) != 9 {
+		t.Errorf("Expected valid sql.Null to convert to Some(9), got %v", o)
+	}
+
+	// Test ToSQLNull
+	if n := ToSQLNull(Some("x")); !n.Valid || n.V != "x" {
+		t.Errorf("Expected Some(\"x\") to convert to a valid sql.Null, got %v", n)
+	}
+	if n := ToSQLNull(None[string]()); n.Valid {
+		t.Errorf("Expected None to convert to an invalid sql.Null")
+	}
+
+	// Round trip through both representations
+	original := Some(int64(12))
+	if back := FromSQLNull(ToSQLNull(original)); back.Unwrap() != 12 {
+		t.Errorf("Expected round trip to preserve the value, got %v", back)
+	}
+}
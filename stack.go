@@ -0,0 +1,113 @@
+package jagain
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"runtime"
+	"strconv"
+	"sync/atomic"
+)
+
+var captureStacks atomic.Bool
+
+// maxStackDepth bounds the number of frames recorded for an error.
+const maxStackDepth = 32
+
+// CaptureStacks controls whether Err records the stack at which each error result is created.
+// Capturing is off by default because walking the stack has a cost on every Err.
+func CaptureStacks(enabled bool) {
+	captureStacks.Store(enabled)
+}
+
+// ErrTraced creates a Result containing err together with the caller's stack,
+// whether or not CaptureStacks is enabled.
+func ErrTraced[T any](err error) Result[T] {
+	err = withStack(err)
+	errCreatedHooks.fire(err, 1)
+	return Result[T]{err: err}.withOrigin(newOrigin())
+}
+
+// StackTrace returns the stack recorded when the error was created, or nil if the Result
+// is Ok or no stack was captured. The stack survives MapErr as long as the new error
+// wraps the old one.
+func (r Result[T]) StackTrace() []runtime.Frame {
+	var st *stackError
+	if r.valid || !errors.As(r.err, &st) {
+		return nil
+	}
+	return st.frames()
+}
+
+// Format implements the fmt.Formatter interface.
+// The %+v verb prints the Result together with its origin in debug builds and the stack
+// recorded for an Err; other verbs print String.
+func (r Result[T]) Format(f fmt.State, verb rune) {
+	switch {
+	case verb == 'v' && f.Flag('+'):
+		io.WriteString(f, r.String())
+		writeOrigin(f, r.originValue())
+		writeFrames(f, r.StackTrace())
+	case verb == 'q':
+		io.WriteString(f, strconv.Quote(r.String()))
+	default:
+		io.WriteString(f, r.String())
+	}
+}
+
+// stackError annotates an error with the stack at which it entered a Result.
+type stackError struct {
+	err error
+	pcs []uintptr
+}
+
+// withStack records the stack of the caller of the function calling withStack.
+// An error that already carries a stack is returned unchanged.
+func withStack(err error) error {
+	var st *stackError
+	if err == nil || errors.As(err, &st) {
+		return err
+	}
+	pcs := make([]uintptr, maxStackDepth)
+	n := runtime.Callers(3, pcs)
+	return &stackError{err: err, pcs: pcs[:n]}
+}
+
+func (e *stackError) Error() string {
+	return e.err.Error()
+}
+
+func (e *stackError) Unwrap() error {
+	return e.err
+}
+
+// Format implements the fmt.Formatter interface, printing the stack for %+v.
+func (e *stackError) Format(f fmt.State, verb rune) {
+	switch {
+	case verb == 'v' && f.Flag('+'):
+		io.WriteString(f, e.Error())
+		writeFrames(f, e.frames())
+	case verb == 'q':
+		io.WriteString(f, strconv.Quote(e.Error()))
+	default:
+		io.WriteString(f, e.Error())
+	}
+}
+
+func (e *stackError) frames() []runtime.Frame {
+	var frames []runtime.Frame
+	iter := runtime.CallersFrames(e.pcs)
+	for {
+		frame, more := iter.Next()
+		frames = append(frames, frame)
+		if !more {
+			return frames
+		}
+	}
+}
+
+func writeFrames(w io.Writer, frames []runtime.Frame) {
+	for _, frame := range frames {
+		fmt.Fprintf(w, "\n%s\n\t%s:%d", frame.Function, frame.File, frame.Line)
+	}
+}
@@ -0,0 +1,52 @@
+package jagain
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+	"testing"
+)
+
+func failLookup() Result[int] {
+	return Err[int](io.EOF)
+}
+
+func TestStackTrace(t *testing.T) {
+	// Test that stacks are off by default
+	if failLookup().StackTrace() != nil {
+		t.Errorf("Expected no stack without CaptureStacks")
+	}
+
+	// Test ErrTraced
+	r := ErrTraced[int](io.EOF)
+	frames := r.StackTrace()
+	if len(frames) == 0 || !strings.HasSuffix(frames[0].Function, "TestStackTrace") {
+		t.Errorf("Expected stack to start at the caller, got %v", frames)
+	}
+	if !errors.Is(r.UnwrapErr(), io.EOF) || r.UnwrapErr().Error() != "EOF" {
+		t.Errorf("Expected traced error to behave like the original")
+	}
+
+	// Test CaptureStacks and survival through MapErr
+	CaptureStacks(true)
+	defer CaptureStacks(false)
+	mapped := failLookup().MapErr(func(err error) error {
+		return fmt.Errorf("lookup: %w", err)
+	})
+	frames = mapped.StackTrace()
+	if len(frames) == 0 || !strings.HasSuffix(frames[0].Function, "failLookup") {
+		t.Errorf("Expected stack to start at failLookup, got %v", frames)
+	}
+
+	// Test formatting
+	if s := fmt.Sprintf("%v", mapped); s != "Err(lookup: EOF)" {
+		t.Errorf("Expected %%v to print the Result only, got %q", s)
+	}
+	if s := fmt.Sprintf("%+v", mapped); !strings.HasPrefix(s, "Err(lookup: EOF)") || !strings.Contains(s, "failLookup") {
+		t.Errorf("Expected %%+v to print the stack, got %q", s)
+	}
+	if s := fmt.Sprintf("%+v", Ok(1)); !strings.HasPrefix(s, "Ok(1)") || strings.Contains(s, "\n") {
+		t.Errorf("Expected Ok to print without a stack, got %q", s)
+	}
+}
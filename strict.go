@@ -0,0 +1,103 @@
+package jagain
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"maps"
+	"reflect"
+	"slices"
+	"strings"
+)
+
+// DecodeStrict decodes a JSON document into T, keeping the difference between absent and
+// null that encoding/json loses. Struct fields are matched by their `json` tag or field name:
+//
+//   - an absent key leaves an Option field as None;
+//   - an absent key for any other field is an error, unless it is tagged omitempty or omitzero;
+//   - null is accepted only by Option fields;
+//   - keys that match no field are an error.
+//
+// Nested structs and slices are checked the same way. Every problem found is reported in
+// the returned ValidationErrors.
+func DecodeStrict[T any](data []byte) Result[T] {
+	var item T
+	var errs ValidationErrors
+	decodeStrict(data, reflect.ValueOf(&item).Elem(), "", &errs)
+	if len(errs) > 0 {
+		return Err[T](errs)
+	}
+	return Ok(item)
+}
+
+// decodeStrict decodes data into v, appending a FieldError for every problem under path.
+func decodeStrict(data []byte, v reflect.Value, path string, errs *ValidationErrors) {
+	null := bytes.Equal(bytes.TrimSpace(data), []byte("null"))
+
+	if o, ok := v.Addr().Interface().(optionValue); ok {
+		if null {
+			v.SetZero()
+			return
+		}
+		inner := reflect.New(o.elemType()).Elem()
+		before := len(*errs)
+		decodeStrict(data, inner, path, errs)
+		if len(*errs) == before {
+			o.setSome(inner)
+		}
+		return
+	}
+	if null {
+		*errs = append(*errs, &FieldError{Path: path, Err: errors.New("null is not allowed")})
+		return
+	}
+	if _, ok := v.Addr().Interface().(json.Unmarshaler); ok {
+		decodeLeaf(data, v, path, errs)
+		return
+	}
+
+	switch {
+	case v.Kind() == reflect.Struct:
+		var object map[string]json.RawMessage
+		if err := json.Unmarshal(data, &object); err != nil {
+			*errs = append(*errs, &FieldError{Path: path, Err: fmt.Errorf("expected an object for %s", v.Type())})
+			return
+		}
+		for _, f := range taggedFields(v.Type(), "json", nil, nil) {
+			field := v.FieldByIndex(f.index)
+			raw, ok := object[f.name]
+			delete(object, f.name)
+			if ok {
+				decodeStrict(raw, field, joinPath(path, f.name), errs)
+				continue
+			}
+			_, isOption := field.Addr().Interface().(optionValue)
+			if !isOption && !strings.Contains(f.options, "omitempty") && !strings.Contains(f.options, "omitzero") {
+				*errs = append(*errs, &FieldError{Path: joinPath(path, f.name), Err: errors.New("field is required")})
+			}
+		}
+		for _, key := range slices.Sorted(maps.Keys(object)) {
+			*errs = append(*errs, &FieldError{Path: joinPath(path, key), Err: errors.New("unknown field")})
+		}
+	case v.Kind() == reflect.Slice && v.Type().Elem().Kind() != reflect.Uint8:
+		var items []json.RawMessage
+		if err := json.Unmarshal(data, &items); err != nil {
+			*errs = append(*errs, &FieldError{Path: path, Err: fmt.Errorf("expected an array for %s", v.Type())})
+			return
+		}
+		slice := reflect.MakeSlice(v.Type(), len(items), len(items))
+		for i, raw := range items {
+			decodeStrict(raw, slice.Index(i), fmt.Sprintf("%s[%d]", path, i), errs)
+		}
+		v.Set(slice)
+	default:
+		decodeLeaf(data, v, path, errs)
+	}
+}
+
+func decodeLeaf(data []byte, v reflect.Value, path string, errs *ValidationErrors) {
+	if err := json.Unmarshal(data, v.Addr().Interface()); err != nil {
+		*errs = append(*errs, &FieldError{Path: path, Err: err})
+	}
+}
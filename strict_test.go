@@ -0,0 +1,79 @@
+package jagain
+
+import (
+	"errors"
+	"strings"
+	"testing"
+	"time"
+)
+
+type strictAddress struct {
+	City string         `json:"city"`
+	Zip  Option[string] `json:"zip"`
+}
+
+type strictUser struct {
+	Name    string                `json:"name"`
+	Email   Option[string]        `json:"email"`
+	Age     Option[int]           `json:"age"`
+	Nick    string                `json:"nick,omitempty"`
+	Created time.Time             `json:"created"`
+	Address Option[strictAddress] `json:"address"`
+	Items   []strictAddress       `json:"items"`
+}
+
+func TestDecodeStrict(t *testing.T) {
+	// Test absent and null Option fields
+	result := DecodeStrict[strictUser]([]byte(`{
+		"name": "Jane",
+		"email": null,
+		"created": "2024-01-02T03:04:05Z",
+		"address": {"city": "Oslo"},
+		"items": []
+	}`))
+	if result.IsErr() {
+		t.Fatalf("Failed to decode: %v", result.UnwrapErr())
+	}
+	user := result.Unwrap()
+	if user.Name != "Jane" || !user.Email.IsNone() || !user.Age.IsNone() {
+		t.Errorf("Expected absent and null Options to be None, got %+v", user)
+	}
+	if user.Address.Unwrap().City != "Oslo" || !user.Address.Unwrap().Zip.IsNone() {
+		t.Errorf("Expected nested Option struct to decode, got %v", user.Address)
+	}
+	if user.Created.Year() != 2024 {
+		t.Errorf("Expected json.Unmarshaler field to decode, got %v", user.Created)
+	}
+
+	// Test that every problem is reported with its path
+	result = DecodeStrict[strictUser]([]byte(`{
+		"name": null,
+		"age": "old",
+		"address": {"zip": "0150"},
+		"items": [{"city": "Oslo"}, {"city": 5}],
+		"extra": true
+	}`))
+	if !result.IsErr() {
+		t.Fatalf("Expected invalid document to fail")
+	}
+	var fieldErr *FieldError
+	if !errors.As(result.UnwrapErr(), &fieldErr) {
+		t.Errorf("Expected a FieldError, got %T", result.UnwrapErr())
+	}
+	msg := result.UnwrapErr().Error()
+	for _, want := range []string{
+		"name: null is not allowed",
+		"age: json: cannot unmarshal",
+		"created: field is required",
+		"address.city: field is required",
+		"items[1].city: json: cannot unmarshal",
+		"extra: unknown field",
+	} {
+		if !strings.Contains(msg, want) {
+			t.Errorf("Expected error to contain %q, got:\n%s", want, msg)
+		}
+	}
+	if strings.Contains(msg, "nick") {
+		t.Errorf("Expected omitempty field to be optional, got:\n%s", msg)
+	}
+}
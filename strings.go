@@ -0,0 +1,70 @@
+package jagain
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Pair holds two values.
+type Pair[A, B any] struct {
+	First  A
+	Second B
+}
+
+// CutOption slices s around the first instance of sep, returning the text before and
+// after it, or None if sep does not appear in s.
+func CutOption(s, sep string) Option[Pair[string, string]] {
+	before, after, found := strings.Cut(s, sep)
+	if !found {
+		return None[Pair[string, string]]()
+	}
+	return Some(Pair[string, string]{before, after})
+}
+
+// IndexOption returns the index of the first instance of sub in s, or None if sub does
+// not appear in s.
+func IndexOption(s, sub string) Option[int] {
+	i := strings.Index(s, sub)
+	if i < 0 {
+		return None[int]()
+	}
+	return Some(i)
+}
+
+// SplitPair splits s around the first instance of sep, like CutOption, for parsers in which
+// the separator is required: a missing separator is an error naming it.
+func SplitPair(s, sep string) Result[Pair[string, string]] {
+	before, after, found := strings.Cut(s, sep)
+	if !found {
+		return Err[Pair[string, string]](fmt.Errorf("separator %q not found in %q", sep, s))
+	}
+	return Ok(Pair[string, string]{before, after})
+}
+
+// JoinSome concatenates the values of the Some Options in opts, separated by sep.
+// None elements are skipped along with their separators; an empty string inside Some is
+// kept, so use NotEmptyString when building opts if empty parts should be skipped too.
+func JoinSome(opts []Option[string], sep string) string {
+	var b strings.Builder
+	first := true
+	for _, o := range opts {
+		if !o.valid {
+			continue
+		}
+		if !first {
+			b.WriteString(sep)
+		}
+		b.WriteString(o.value)
+		first = false
+	}
+	return b.String()
+}
+
+// FormatOption formats the Option's value with fmt.Sprintf and format, or returns fallback
+// if the Option is None.
+func FormatOption[T any](o Option[T], format, fallback string) string {
+	if !o.valid {
+		return fallback
+	}
+	return fmt.Sprintf(format, o.value)
+}
@@ -0,0 +1,58 @@
+package jagain
+
+import "testing"
+
+func TestStringHelpers(t *testing.T) {
+	// Test CutOption
+	if got := CutOption("key=value=x", "="); got.IsNone() || got.Unwrap() != (Pair[string, string]{"key", "value=x"}) {
+		t.Errorf("Expected Some({key value=x}), got %v", got)
+	}
+	if !CutOption("key", "=").IsNone() {
+		t.Errorf("Expected None without the separator")
+	}
+
+	// Test IndexOption
+	if got := IndexOption("chicken", "ken"); got.UnwrapOr(-1) != 4 {
+		t.Errorf("Expected Some(4), got %v", got)
+	}
+	if got := IndexOption("chicken", ""); got.UnwrapOr(-1) != 0 {
+		t.Errorf("Expected Some(0) for an empty substring, got %v", got)
+	}
+	if !IndexOption("chicken", "dmr").IsNone() {
+		t.Errorf("Expected None for a missing substring")
+	}
+
+	// Test SplitPair
+	if got := SplitPair("host:8080", ":"); got.IsErr() || got.Unwrap().Second != "8080" {
+		t.Errorf("Expected Ok({host 8080}), got %v", got)
+	}
+	if got := SplitPair("host", ":"); got.IsOk() || got.UnwrapErr().Error() != `separator ":" not found in "host"` {
+		t.Errorf("Expected a separator error, got %v", got)
+	}
+}
+
+func TestJoinSome(t *testing.T) {
+	// Test building an address line from partial data
+	city, state, zip := Some("Portland"), None[string](), Some("97201")
+	if got := JoinSome([]Option[string]{city, state}, ", ") + FormatOption(zip, " %s", ""); got != "Portland 97201" {
+		t.Errorf("Expected \"Portland 97201\", got %q", got)
+	}
+	if got := JoinSome([]Option[string]{None[string](), Some("a"), None[string](), Some("b")}, ", "); got != "a, b" {
+		t.Errorf("Expected \"a, b\", got %q", got)
+	}
+	if got := JoinSome([]Option[string]{Some(""), Some("a")}, ","); got != ",a" {
+		t.Errorf("Expected empty strings to be kept, got %q", got)
+	}
+	if got := JoinSome([]Option[string]{None[string]()}, ", "); got != "" {
+		t.Errorf("Expected an empty string, got %q", got)
+	}
+}
+
+func TestFormatOption(t *testing.T) {
+	if got := FormatOption(Some(3.5), "%.2f kg", "unknown"); got != "3.50 kg" {
+		t.Errorf("Expected \"3.50 kg\", got %q", got)
+	}
+	if got := FormatOption(None[float64](), "%.2f kg", "unknown"); got != "unknown" {
+		t.Errorf("Expected \"unknown\", got %q", got)
+	}
+}
@@ -0,0 +1,73 @@
+package jagain
+
+import "reflect"
+
+// TemplateFuncs returns functions that let text/template and html/template render Option
+// fields directly, instead of flattening them into a view model first:
+//
+//	isSome x        whether x holds a value
+//	isNone x        whether x is empty
+//	someOr x def    the value of x, or def if x is empty
+//	deref x         the value of x, or the zero value of its type if x is empty
+//
+// x may be an Option, a pointer to one, or any other pointer, which is empty when nil.
+// Other values are always present. The map can be passed to the Funcs method of either
+// template package:
+//
+//	tmpl := template.Must(template.New("user").Funcs(jagain.TemplateFuncs()).Parse(
+//		`Hello, {{someOr .Nickname "stranger"}}!`))
+func TemplateFuncs() map[string]any {
+	return map[string]any{
+		"isSome": func(x any) bool {
+			_, ok := templateValue(x)
+			return ok
+		},
+		"isNone": func(x any) bool {
+			_, ok := templateValue(x)
+			return !ok
+		},
+		"someOr": func(x, fallback any) any {
+			if v, ok := templateValue(x); ok {
+				return v.Interface()
+			}
+			return fallback
+		},
+		"deref": func(x any) any {
+			v, _ := templateValue(x)
+			if !v.IsValid() {
+				return ""
+			}
+			return v.Interface()
+		},
+	}
+}
+
+// templateValue returns the value held by x and whether it is present. An absent value
+// is returned as the zero value of its type, or as an invalid Value if x is nil.
+func templateValue(x any) (reflect.Value, bool) {
+	v := reflect.ValueOf(x)
+	if !v.IsValid() {
+		return v, false
+	}
+	if v.Kind() != reflect.Pointer {
+		p := reflect.New(v.Type())
+		if _, ok := p.Interface().(optionValue); !ok {
+			return v, true
+		}
+		p.Elem().Set(v)
+		v = p
+	}
+
+	if o, ok := v.Interface().(optionValue); ok {
+		if !v.IsNil() {
+			if value, ok := o.someValue(); ok {
+				return value, true
+			}
+		}
+		return reflect.Zero(o.elemType()), false
+	}
+	if v.IsNil() {
+		return reflect.Zero(v.Type().Elem()), false
+	}
+	return v.Elem(), true
+}
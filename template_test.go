@@ -0,0 +1,51 @@
+package jagain
+
+import (
+	"html/template"
+	"strings"
+	"testing"
+	texttemplate "text/template"
+)
+
+type profileView struct {
+	Name     string
+	Nickname Option[string]
+	Age      Option[int]
+	Website  *string
+	Bio      *Option[string]
+}
+
+func TestTemplateFuncs(t *testing.T) {
+	const page = `{{someOr .Nickname .Name}}|{{deref .Age}}|{{if isSome .Website}}{{deref .Website}}{{else}}none{{end}}|{{someOr .Bio "-"}}|{{isNone .Name}}`
+	tmpl := template.Must(template.New("profile").Funcs(TemplateFuncs()).Parse(page))
+
+	// Test rendering present values
+	site := "https://example.com/<jane>"
+	bio := Some("hi")
+	var b strings.Builder
+	if err := tmpl.Execute(&b, profileView{Name: "Jane", Nickname: Some("jj"), Age: Some(30), Website: &site, Bio: &bio}); err != nil {
+		t.Fatalf("Failed to execute template: %v", err)
+	}
+	if want := "jj|30|https://example.com/&lt;jane&gt;|hi|false"; b.String() != want {
+		t.Errorf("Expected %q, got %q", want, b.String())
+	}
+
+	// Test rendering fallbacks for absent values
+	b.Reset()
+	if err := tmpl.Execute(&b, profileView{Name: "Jane"}); err != nil {
+		t.Fatalf("Failed to execute template: %v", err)
+	}
+	if want := "Jane|0|none|-|false"; b.String() != want {
+		t.Errorf("Expected %q, got %q", want, b.String())
+	}
+
+	// Test text/template and untyped nil
+	text := texttemplate.Must(texttemplate.New("nil").Funcs(TemplateFuncs()).Parse(`[{{deref .}}]{{isNone .}}`))
+	b.Reset()
+	if err := text.Execute(&b, nil); err != nil {
+		t.Fatalf("Failed to execute template: %v", err)
+	}
+	if b.String() != "[]true" {
+		t.Errorf("Expected \"[]true\", got %q", b.String())
+	}
+}
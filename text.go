@@ -0,0 +1,118 @@
+package jagain
+
+import (
+	"encoding"
+	"fmt"
+	"reflect"
+	"strconv"
+)
+
+// StrictText is an Option whose UnmarshalText decodes empty text as T instead of None,
+// so a StrictText[string] becomes Some("") and types that cannot parse empty text report
+// an error. Use it in place of Option for the fields or map keys that need strict parsing;
+// its other methods are those of the embedded Option.
+type StrictText[T any] struct {
+	Option[T]
+}
+
+// UnmarshalText implements the encoding.TextUnmarshaler interface.
+func (s *StrictText[T]) UnmarshalText(text []byte) error {
+	var value T
+	if err := unmarshalText(text, &value); err != nil {
+		return err
+	}
+	s.Option = Some(value)
+	return nil
+}
+
+// MarshalText implements the encoding.TextMarshaler interface.
+// None is encoded as empty text.
+func (o Option[T]) MarshalText() ([]byte, error) {
+	if !o.valid {
+		return []byte{}, nil
+	}
+	return marshalText(o.value)
+}
+
+// UnmarshalText implements the encoding.TextUnmarshaler interface.
+// Empty text decodes to None; use StrictText to decode it as T instead.
+func (o *Option[T]) UnmarshalText(text []byte) error {
+	if len(text) == 0 {
+		*o = None[T]()
+		return nil
+	}
+
+	var value T
+	if err := unmarshalText(text, &value); err != nil {
+		return err
+	}
+
+	*o = Some(value)
+	return nil
+}
+
+// marshalText encodes a value as text, preferring its own encoding.TextMarshaler.
+func marshalText(value any) ([]byte, error) {
+	if m, ok := value.(encoding.TextMarshaler); ok {
+		return m.MarshalText()
+	}
+
+	v := reflect.ValueOf(value)
+	switch v.Kind() {
+	case reflect.String:
+		return []byte(v.String()), nil
+	case reflect.Bool:
+		return strconv.AppendBool(nil, v.Bool()), nil
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return strconv.AppendInt(nil, v.Int(), 10), nil
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		return strconv.AppendUint(nil, v.Uint(), 10), nil
+	case reflect.Float32, reflect.Float64:
+		return strconv.AppendFloat(nil, v.Float(), 'g', -1, v.Type().Bits()), nil
+	}
+	return nil, fmt.Errorf("cannot marshal %T as text", value)
+}
+
+// unmarshalText decodes text into dst, preferring its own encoding.TextUnmarshaler.
+func unmarshalText(text []byte, dst any) error {
+	if u, ok := dst.(encoding.TextUnmarshaler); ok {
+		return u.UnmarshalText(text)
+	}
+
+	v := reflect.ValueOf(dst).Elem()
+	s := string(text)
+	switch v.Kind() {
+	case reflect.String:
+		v.SetString(s)
+		return nil
+	case reflect.Bool:
+		b, err := strconv.ParseBool(s)
+		if err != nil {
+			return err
+		}
+		v.SetBool(b)
+		return nil
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		n, err := strconv.ParseInt(s, 10, v.Type().Bits())
+		if err != nil {
+			return err
+		}
+		v.SetInt(n)
+		return nil
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		n, err := strconv.ParseUint(s, 10, v.Type().Bits())
+		if err != nil {
+			return err
+		}
+		v.SetUint(n)
+		return nil
+	case reflect.Float32, reflect.Float64:
+		n, err := strconv.ParseFloat(s, v.Type().Bits())
+		if err != nil {
+			return err
+		}
+		v.SetFloat(n)
+		return nil
+	}
+	return fmt.Errorf("cannot unmarshal text into %s", v.Type())
+}
@@ -0,0 +1,63 @@
+package jagain
+
+import (
+	"encoding/json"
+	"net"
+	"testing"
+)
+
+func TestOptionText(t *testing.T) {
+	// Test MarshalText
+	text, err := Some(42).MarshalText()
+	if err != nil || string(text) != "42" {
+		t.Errorf("Expected Some(42) to marshal as \"42\", got %q (%v)", text, err)
+	}
+	text, err = None[int]().MarshalText()
+	if err != nil || len(text) != 0 {
+		t.Errorf("Expected None to marshal as empty text, got %q (%v)", text, err)
+	}
+	text, err = Some(net.IPv4(127, 0, 0, 1)).MarshalText()
+	if err != nil || string(text) != "127.0.0.1" {
+		t.Errorf("Expected inner TextMarshaler to be used, got %q (%v)", text, err)
+	}
+
+	// Test UnmarshalText
+	var i Option[int]
+	if err := i.UnmarshalText([]byte("17")); err != nil || i.Unwrap() != 17 {
+		t.Errorf("Expected \"17\" to unmarshal as Some(17), got %v (%v)", i, err)
+	}
+	if err := i.UnmarshalText([]byte("")); err != nil || !i.IsNone() {
+		t.Errorf("Expected empty text to unmarshal as None, got %v (%v)", i, err)
+	}
+	if err := i.UnmarshalText([]byte("x")); err == nil {
+		t.Errorf("Expected invalid text to fail")
+	}
+
+	var ip Option[net.IP]
+	if err := ip.UnmarshalText([]byte("10.0.0.1")); err != nil || ip.Unwrap().String() != "10.0.0.1" {
+		t.Errorf("Expected inner TextUnmarshaler to be used, got %v (%v)", ip, err)
+	}
+
+	// Test strict parsing
+	var s StrictText[string]
+	if err := s.UnmarshalText([]byte("")); err != nil || !s.IsSome() || s.Unwrap() != "" {
+		t.Errorf("Expected empty text to unmarshal as Some(\"\") with StrictText, got %v (%v)", s, err)
+	}
+	var si StrictText[int]
+	if err := si.UnmarshalText([]byte("")); err == nil {
+		t.Errorf("Expected empty text to fail for StrictText[int]")
+	}
+	if err := si.UnmarshalText([]byte("7")); err != nil || si.Unwrap() != 7 {
+		t.Errorf("Expected StrictText[int] to parse 7, got %v (%v)", si, err)
+	}
+	if err := i.UnmarshalText([]byte("")); err != nil || !i.IsNone() {
+		t.Errorf("Expected plain Options to stay lenient, got %v (%v)", i, err)
+	}
+
+	// Test usage as a JSON map key
+	m := map[Option[string]]int{Some("a"): 1}
+	bytes, err := json.Marshal(m)
+	if err != nil || string(bytes) != `{"a":1}` {
+		t.Errorf("Expected Option map key to marshal as text, got %s (%v)", bytes, err)
+	}
+}
@@ -0,0 +1,48 @@
+package jagain
+
+import "time"
+
+// SomeTime returns Some(t), or None if t is the zero time. Many APIs and databases use the
+// zero time to mean "not set"; SomeTime turns that convention into an explicit Option.
+func SomeTime(t time.Time) Option[time.Time] {
+	if t.IsZero() {
+		return None[time.Time]()
+	}
+	return Some(t)
+}
+
+// TimeSince returns the time elapsed since the Option's time, or None if there is no time.
+func TimeSince(o Option[time.Time]) Option[time.Duration] {
+	if !o.valid {
+		return None[time.Duration]()
+	}
+	return Some(time.Since(o.value))
+}
+
+// TimeUntil returns the duration until the Option's time, or None if there is no time.
+func TimeUntil(o Option[time.Time]) Option[time.Duration] {
+	if !o.valid {
+		return None[time.Duration]()
+	}
+	return Some(time.Until(o.value))
+}
+
+// CompareTime compares two optional times, returning -1, 0 or +1 like time.Time.Compare.
+// None sorts before every time and equals None.
+func CompareTime(a, b Option[time.Time]) int {
+	switch {
+	case !a.valid && !b.valid:
+		return 0
+	case !a.valid:
+		return -1
+	case !b.valid:
+		return 1
+	}
+	return a.value.Compare(b.value)
+}
+
+// EqualTime reports whether two optional times are both None or both Some of the same
+// instant. Unlike ==, it ignores the location and monotonic clock reading.
+func EqualTime(a, b Option[time.Time]) bool {
+	return CompareTime(a, b) == 0
+}
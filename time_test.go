@@ -0,0 +1,39 @@
+package jagain
+
+import (
+	"testing"
+	"time"
+)
+
+func TestTimeHelpers(t *testing.T) {
+	now := time.Now()
+
+	// Test SomeTime
+	if !SomeTime(time.Time{}).IsNone() || !SomeTime(now).IsSome() {
+		t.Errorf("Expected the zero time to be None and others Some")
+	}
+
+	// Test TimeSince and TimeUntil
+	if d := TimeSince(Some(now.Add(-time.Hour))).UnwrapOr(0); d < time.Hour {
+		t.Errorf("Expected at least an hour since, got %v", d)
+	}
+	if d := TimeUntil(Some(now.Add(time.Hour))).UnwrapOr(0); d <= 0 || d > time.Hour {
+		t.Errorf("Expected up to an hour until, got %v", d)
+	}
+	if !TimeSince(None[time.Time]()).IsNone() || !TimeUntil(None[time.Time]()).IsNone() {
+		t.Errorf("Expected None to give None")
+	}
+
+	// Test CompareTime and EqualTime
+	none := None[time.Time]()
+	earlier, later := Some(now), Some(now.Add(time.Second))
+	if CompareTime(none, earlier) != -1 || CompareTime(later, earlier) != 1 || CompareTime(none, none) != 0 {
+		t.Errorf("Expected None first, then times in order")
+	}
+	if !EqualTime(Some(now), Some(now.UTC())) {
+		t.Errorf("Expected the same instant in another location to be equal")
+	}
+	if EqualTime(none, earlier) || !EqualTime(none, none) {
+		t.Errorf("Expected None to equal only None")
+	}
+}
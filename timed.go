@@ -0,0 +1,24 @@
+package jagain
+
+import "time"
+
+// Timed calls f and returns its Result together with how long the call took.
+func Timed[T any](f func() Result[T]) (Result[T], time.Duration) {
+	start := time.Now()
+	r := f()
+	return r, time.Since(start)
+}
+
+// TimedResult is a Result together with how long the call that produced it took, so the
+// duration can be logged or exported with the outcome of the call, whether Ok or Err.
+// Its other methods are those of the embedded Result.
+type TimedResult[T any] struct {
+	Result[T]
+	Duration time.Duration
+}
+
+// TimeResult calls f and returns its Result with the duration of the call attached.
+func TimeResult[T any](f func() Result[T]) TimedResult[T] {
+	r, elapsed := Timed(f)
+	return TimedResult[T]{Result: r, Duration: elapsed}
+}
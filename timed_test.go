@@ -0,0 +1,29 @@
+package jagain
+
+import (
+	"testing"
+	"time"
+)
+
+func TestTimed(t *testing.T) {
+	r, elapsed := Timed(func() Result[int] {
+		time.Sleep(5 * time.Millisecond)
+		return Ok(1)
+	})
+	if r.UnwrapOr(0) != 1 || elapsed < 5*time.Millisecond {
+		t.Errorf("Expected Ok(1) after at least 5ms, got %v after %v", r, elapsed)
+	}
+}
+
+func TestTimeResult(t *testing.T) {
+	// Test that Ok and Err results both carry the duration
+	for _, want := range []Result[int]{Ok(1), Err[int](ErrNoValue)} {
+		r := TimeResult(func() Result[int] {
+			time.Sleep(5 * time.Millisecond)
+			return want
+		})
+		if r.IsOk() != want.IsOk() || r.Duration < 5*time.Millisecond {
+			t.Errorf("Expected %v after at least 5ms, got %v after %v", want, r.Result, r.Duration)
+		}
+	}
+}
@@ -0,0 +1,107 @@
+package jagain
+
+import (
+	"bytes"
+	"encoding"
+	"encoding/json"
+	"fmt"
+	"math"
+	"reflect"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// MarshalTOML implements the Marshaler interface of github.com/BurntSushi/toml and
+// the unstable.Marshaler interface of github.com/pelletier/go-toml/v2.
+// TOML has no null, so None encodes as empty output: pelletier omits the key when its
+// marshaler interface is enabled, and BurntSushi omits it when the field is tagged omitempty.
+func (o Option[T]) MarshalTOML() ([]byte, error) {
+	if !o.valid {
+		return []byte{}, nil
+	}
+	return marshalTOMLValue(reflect.ValueOf(o.value))
+}
+
+// UnmarshalTOML implements the Unmarshaler interface of github.com/BurntSushi/toml.
+// A key missing from the document leaves the Option as None.
+// The pelletier decoder uses UnmarshalText instead, which covers string values.
+func (o *Option[T]) UnmarshalTOML(data any) error {
+	var value T
+	if err := assignValue(data, &value); err != nil {
+		return err
+	}
+
+	*o = Some(value)
+	return nil
+}
+
+// marshalTOMLValue renders a scalar or array as an inline TOML value.
+func marshalTOMLValue(v reflect.Value) ([]byte, error) {
+	switch value := v.Interface().(type) {
+	case time.Time:
+		return []byte(value.Format(time.RFC3339Nano)), nil
+	case encoding.TextMarshaler:
+		text, err := value.MarshalText()
+		if err != nil {
+			return nil, err
+		}
+		return quoteTOML(string(text))
+	}
+
+	switch v.Kind() {
+	case reflect.String:
+		return quoteTOML(v.String())
+	case reflect.Bool:
+		return strconv.AppendBool(nil, v.Bool()), nil
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return strconv.AppendInt(nil, v.Int(), 10), nil
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return strconv.AppendUint(nil, v.Uint(), 10), nil
+	case reflect.Float32, reflect.Float64:
+		return formatTOMLFloat(v.Float(), v.Type().Bits()), nil
+	case reflect.Slice, reflect.Array:
+		var buf bytes.Buffer
+		buf.WriteByte('[')
+		for i := 0; i < v.Len(); i++ {
+			if i > 0 {
+				buf.WriteString(", ")
+			}
+			elem, err := marshalTOMLValue(v.Index(i))
+			if err != nil {
+				return nil, err
+			}
+			buf.Write(elem)
+		}
+		buf.WriteByte(']')
+		return buf.Bytes(), nil
+	}
+	return nil, fmt.Errorf("cannot marshal %s as a TOML value", v.Type())
+}
+
+// quoteTOML renders s as a TOML basic string; JSON string escapes are a subset of TOML's.
+func quoteTOML(s string) ([]byte, error) {
+	var buf bytes.Buffer
+	enc := json.NewEncoder(&buf)
+	enc.SetEscapeHTML(false)
+	if err := enc.Encode(s); err != nil {
+		return nil, err
+	}
+	return bytes.TrimSuffix(buf.Bytes(), []byte("\n")), nil
+}
+
+func formatTOMLFloat(f float64, bits int) []byte {
+	switch {
+	case math.IsNaN(f):
+		return []byte("nan")
+	case math.IsInf(f, 1):
+		return []byte("inf")
+	case math.IsInf(f, -1):
+		return []byte("-inf")
+	}
+	s := strconv.FormatFloat(f, 'g', -1, bits)
+	if !strings.ContainsAny(s, ".e") {
+		s += ".0"
+	}
+	return []byte(s)
+}
@@ -0,0 +1,68 @@
+package jagain
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/BurntSushi/toml"
+)
+
+type tomlConfig struct {
+	Name    string           `toml:"name"`
+	Port    Option[int]      `toml:"port,omitempty"`
+	Debug   Option[bool]     `toml:"debug,omitempty"`
+	Ratio   Option[float64]  `toml:"ratio,omitempty"`
+	Tags    Option[[]string] `toml:"tags,omitempty"`
+	Comment Option[string]   `toml:"comment,omitempty"`
+}
+
+func TestOptionTOML(t *testing.T) {
+	// Test decoding with BurntSushi
+	var cfg tomlConfig
+	_, err := toml.Decode(`
+name = "svc"
+port = 8080
+ratio = 2
+tags = ["a", "b"]
+`, &cfg)
+	if err != nil {
+		t.Fatalf("Failed to decode TOML: %v", err)
+	}
+	if cfg.Port.UnwrapOr(0) != 8080 {
+		t.Errorf("Expected port to be Some(8080), got %v", cfg.Port)
+	}
+	if cfg.Ratio.UnwrapOr(0) != 2 {
+		t.Errorf("Expected ratio to be Some(2.0), got %v", cfg.Ratio)
+	}
+	if tags := cfg.Tags.UnwrapOr(nil); len(tags) != 2 || tags[1] != "b" {
+		t.Errorf("Expected tags to be Some([a b]), got %v", cfg.Tags)
+	}
+	if !cfg.Debug.IsNone() || !cfg.Comment.IsNone() {
+		t.Errorf("Expected omitted keys to be None, got %v and %v", cfg.Debug, cfg.Comment)
+	}
+
+	// Test invalid conversions
+	var bad tomlConfig
+	if _, err := toml.Decode(`port = "x"`, &bad); err == nil {
+		t.Errorf("Expected string port to fail")
+	}
+	if _, err := toml.Decode(`port = 1.5`, &bad); err == nil {
+		t.Errorf("Expected fractional port to fail")
+	}
+
+	// Test encoding with BurntSushi
+	var sb strings.Builder
+	cfg.Comment = Some(`say "hi"`)
+	if err := toml.NewEncoder(&sb).Encode(cfg); err != nil {
+		t.Fatalf("Failed to encode TOML: %v", err)
+	}
+	out := sb.String()
+	for _, want := range []string{`port = 8080`, `ratio = 2.0`, `tags = ["a", "b"]`, `comment = "say \"hi\""`} {
+		if !strings.Contains(out, want) {
+			t.Errorf("Expected encoded TOML to contain %q, got:\n%s", want, out)
+		}
+	}
+	if strings.Contains(out, "debug") {
+		t.Errorf("Expected None to be omitted, got:\n%s", out)
+	}
+}
@@ -0,0 +1,40 @@
+package jagain
+
+import (
+	"net/url"
+	"strconv"
+)
+
+// ParseURLR parses rawURL, like url.Parse.
+func ParseURLR(rawURL string) Result[*url.URL] {
+	u, err := url.Parse(rawURL)
+	if err != nil {
+		return Err[*url.URL](err)
+	}
+	return Ok(u)
+}
+
+// QueryParam returns the first value of the query parameter key in u, or None if the
+// parameter is absent. A parameter given without a value, as in "?q=", is Some("").
+func QueryParam(u *url.URL, key string) Option[string] {
+	values := u.Query()
+	if !values.Has(key) {
+		return None[string]()
+	}
+	return Some(values.Get(key))
+}
+
+// QueryInt returns the first value of the query parameter key in u as an int, or None if
+// the parameter is absent or not an integer. Use DecodeQuery when invalid input must be
+// reported.
+func QueryInt(u *url.URL, key string) Option[int] {
+	s := QueryParam(u, key)
+	if !s.valid {
+		return None[int]()
+	}
+	n, err := strconv.Atoi(s.value)
+	if err != nil {
+		return None[int]()
+	}
+	return Some(n)
+}
@@ -0,0 +1,31 @@
+package jagain
+
+import "testing"
+
+func TestURLHelpers(t *testing.T) {
+	// Test ParseURLR
+	r := ParseURLR("https://example.com/items?limit=10&q=&page=x")
+	if r.IsErr() {
+		t.Fatalf("Expected Ok, got %v", r.UnwrapErr())
+	}
+	if ParseURLR("://bad").IsOk() {
+		t.Errorf("Expected an error for a URL without a scheme")
+	}
+	u := r.Unwrap()
+
+	// Test QueryParam
+	if got := QueryParam(u, "q"); got.UnwrapOr("missing") != "" {
+		t.Errorf("Expected Some(\"\") for an empty parameter, got %v", got)
+	}
+	if got := QueryParam(u, "sort"); !got.IsNone() {
+		t.Errorf("Expected None for an absent parameter, got %v", got)
+	}
+
+	// Test QueryInt
+	if got := QueryInt(u, "limit"); got.UnwrapOr(0) != 10 {
+		t.Errorf("Expected Some(10), got %v", got)
+	}
+	if !QueryInt(u, "page").IsNone() || !QueryInt(u, "offset").IsNone() {
+		t.Errorf("Expected invalid and absent parameters to be None")
+	}
+}
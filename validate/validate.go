@@ -0,0 +1,160 @@
+// Package validate provides composable validators that report their outcome as a jagain.Result.
+package validate
+
+import (
+	"cmp"
+	"errors"
+	"fmt"
+	"regexp"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/dendianugerah/jagain"
+)
+
+// Validator checks a value, returning it unchanged in an Ok result when it is valid.
+// Combinators report failures as jagain.ValidationErrors, with the path of each field.
+type Validator[T any] func(T) jagain.Result[T]
+
+// Func creates a Validator from a function that returns nil for valid values.
+func Func[T any](check func(T) error) Validator[T] {
+	return func(v T) jagain.Result[T] {
+		if err := check(v); err != nil {
+			return jagain.Err[T](err)
+		}
+		return jagain.Ok(v)
+	}
+}
+
+// Error runs the validator and returns its error, or nil when v is valid.
+// It adapts a Validator to jagain.RegisterValidator.
+func (f Validator[T]) Error(v T) error {
+	if r := f(v); r.IsErr() {
+		return r.UnwrapErr()
+	}
+	return nil
+}
+
+// NotEmpty requires a non-empty string.
+func NotEmpty[S ~string]() Validator[S] {
+	return Func(func(s S) error {
+		if s == "" {
+			return errors.New("must not be empty")
+		}
+		return nil
+	})
+}
+
+// MinLen requires a string of at least n characters.
+func MinLen[S ~string](n int) Validator[S] {
+	return Func(func(s S) error {
+		if utf8.RuneCountInString(string(s)) < n {
+			return fmt.Errorf("must be at least %d characters", n)
+		}
+		return nil
+	})
+}
+
+// Matches requires a string matching re.
+func Matches[S ~string](re *regexp.Regexp) Validator[S] {
+	return Func(func(s S) error {
+		if !re.MatchString(string(s)) {
+			return fmt.Errorf("must match %s", re)
+		}
+		return nil
+	})
+}
+
+// InRange requires a value between min and max inclusive.
+func InRange[N cmp.Ordered](min, max N) Validator[N] {
+	return Func(func(n N) error {
+		if n < min || n > max {
+			return fmt.Errorf("must be between %v and %v", min, max)
+		}
+		return nil
+	})
+}
+
+// All runs every validator and reports all of their failures together.
+func All[T any](validators ...Validator[T]) Validator[T] {
+	return func(v T) jagain.Result[T] {
+		var errs jagain.ValidationErrors
+		var failed jagain.Result[T]
+		for _, validate := range validators {
+			if r := validate(v); r.IsErr() {
+				errs = append(errs, jagain.ValidationErrorsOf(r.UnwrapErr())...)
+				failed = r
+			}
+		}
+		if len(errs) > 0 {
+			return failWith[T](failed, errs)
+		}
+		return jagain.Ok(v)
+	}
+}
+
+// Each applies v to every element of a slice, reporting failures under their index.
+func Each[T any](v Validator[T]) Validator[[]T] {
+	return func(items []T) jagain.Result[[]T] {
+		var errs jagain.ValidationErrors
+		var failed jagain.Result[T]
+		for i, item := range items {
+			if r := v(item); r.IsErr() {
+				errs = append(errs, prefix(fmt.Sprintf("[%d]", i), r.UnwrapErr())...)
+				failed = r
+			}
+		}
+		if len(errs) > 0 {
+			return failWith[[]T](failed, errs)
+		}
+		return jagain.Ok(items)
+	}
+}
+
+// Field applies v to the part of T selected by get, reporting failures under name.
+func Field[T, F any](name string, get func(T) F, v Validator[F]) Validator[T] {
+	return func(item T) jagain.Result[T] {
+		if r := v(get(item)); r.IsErr() {
+			return failWith[T](r, prefix(name, r.UnwrapErr()))
+		}
+		return jagain.Ok(item)
+	}
+}
+
+// Optional applies v to the value of a Some option; None is always valid.
+func Optional[T any](v Validator[T]) Validator[jagain.Option[T]] {
+	return func(o jagain.Option[T]) jagain.Result[jagain.Option[T]] {
+		if o.IsSome() {
+			if r := v(o.Unwrap()); r.IsErr() {
+				return failWith[jagain.Option[T]](r, r.UnwrapErr())
+			}
+		}
+		return jagain.Ok(o)
+	}
+}
+
+// failWith returns a failure of type U holding err, made from the failed Result r. Going
+// through jagain.MapTo rather than jagain.Err keeps the OnErrCreated hooks from running
+// again for failures that the validators inside a combinator already reported.
+func failWith[U, T any](r jagain.Result[T], err error) jagain.Result[U] {
+	return jagain.MapTo(r.MapErr(func(error) error { return err }), func(T) U {
+		var zero U
+		return zero
+	})
+}
+
+// prefix places the field errors of err under path.
+func prefix(path string, err error) jagain.ValidationErrors {
+	errs := jagain.ValidationErrorsOf(err)
+	for i, fe := range errs {
+		switch {
+		case fe.Path == "":
+			errs[i] = &jagain.FieldError{Path: path, Err: fe.Err}
+		case strings.HasPrefix(fe.Path, "["):
+			errs[i] = &jagain.FieldError{Path: path + fe.Path, Err: fe.Err}
+		default:
+			errs[i] = &jagain.FieldError{Path: path + "." + fe.Path, Err: fe.Err}
+		}
+	}
+	return errs
+}
@@ -0,0 +1,92 @@
+package validate
+
+import (
+	"errors"
+	"regexp"
+	"runtime"
+	"strings"
+	"testing"
+
+	"github.com/dendianugerah/jagain"
+)
+
+type address struct {
+	Zip string
+}
+
+type customer struct {
+	Name      string
+	Age       int
+	Nickname  jagain.Option[string]
+	Addresses []address
+}
+
+func TestValidators(t *testing.T) {
+	// Test leaf validators
+	if NotEmpty[string]()("").IsOk() || NotEmpty[string]()("a").IsErr() {
+		t.Errorf("Expected NotEmpty to reject only empty strings")
+	}
+	if MinLen[string](3)("hé").IsOk() || MinLen[string](2)("hé").IsErr() {
+		t.Errorf("Expected MinLen to count characters")
+	}
+	if Matches[string](regexp.MustCompile(`^\d+$`))("12a").IsOk() {
+		t.Errorf("Expected Matches to reject non-matching strings")
+	}
+	if InRange(1, 10)(0).IsOk() || InRange(1, 10)(10).IsErr() {
+		t.Errorf("Expected InRange to be inclusive")
+	}
+	if Optional(MinLen[string](3))(jagain.None[string]()).IsErr() {
+		t.Errorf("Expected Optional to accept None")
+	}
+
+	// Test composing validators over a struct
+	validCustomer := All(
+		Field("name", func(c customer) string { return c.Name }, All(NotEmpty[string](), MinLen[string](2))),
+		Field("age", func(c customer) int { return c.Age }, InRange(18, 130)),
+		Field("nickname", func(c customer) jagain.Option[string] { return c.Nickname }, Optional(MinLen[string](3))),
+		Field("addresses", func(c customer) []address { return c.Addresses }, Each(
+			Field("zip", func(a address) string { return a.Zip }, Matches[string](regexp.MustCompile(`^\d{5}$`))),
+		)),
+	)
+
+	good := customer{Name: "Jane", Age: 30, Addresses: []address{{Zip: "12345"}}}
+	if r := validCustomer(good); r.IsErr() {
+		t.Errorf("Expected valid customer to pass, got %v", r.UnwrapErr())
+	}
+
+	r := validCustomer(customer{Age: 12, Nickname: jagain.Some("J"), Addresses: []address{{Zip: "12345"}, {Zip: "x"}}})
+	if r.IsOk() {
+		t.Fatalf("Expected invalid customer to fail")
+	}
+	msg := r.UnwrapErr().Error()
+	for _, want := range []string{
+		"name: must not be empty",
+		"name: must be at least 2 characters",
+		"age: must be between 18 and 130",
+		"nickname: must be at least 3 characters",
+		"addresses[1].zip: must match",
+	} {
+		if !strings.Contains(msg, want) {
+			t.Errorf("Expected error to contain %q, got:\n%s", want, msg)
+		}
+	}
+
+	// Test that combinators do not report the failures of nested validators again
+	created := 0
+	remove := jagain.OnErrCreated(func(error, runtime.Frame) { created++ })
+	validCustomer(customer{Age: 12, Nickname: jagain.Some("J"), Addresses: []address{{Zip: "12345"}, {Zip: "x"}}})
+	remove()
+	if created != 5 {
+		t.Errorf("Expected one hook call per failed check, got %d", created)
+	}
+
+	var fieldErr *jagain.FieldError
+	if !errors.As(r.UnwrapErr(), &fieldErr) {
+		t.Errorf("Expected FieldErrors, got %T", r.UnwrapErr())
+	}
+
+	// Test adapting to Bind's validator registry
+	if validCustomer.Error(good) != nil || validCustomer.Error(customer{}) == nil {
+		t.Errorf("Expected Error to report only failures")
+	}
+}
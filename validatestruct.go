@@ -0,0 +1,176 @@
+package jagain
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"slices"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+)
+
+// ValidateStruct checks v against the rules in its `jagain` struct tags and returns it
+// unchanged when every rule holds. Rules are separated by commas:
+//
+//   - required: an Option must be Some; any other field must not be its zero value.
+//   - min=N, max=N: bounds the length of strings (in characters), slices and maps,
+//     or the value of numbers.
+//   - oneof=a b c: the value, formatted as text, must be one of the listed words.
+//
+// Rules other than required apply to the value inside an Option and are skipped for None.
+// Nested structs, including those inside Options and slices, are validated too. Failures
+// are reported as ValidationErrors, with paths built from the json names of the fields, or
+// their Go names for fields without one or tagged json:"-", which are validated as well.
+func ValidateStruct[T any](v T) Result[T] {
+	rv := reflect.ValueOf(&v).Elem()
+	if rv.Kind() != reflect.Struct {
+		return Err[T](fmt.Errorf("validate struct: %s is not a struct", rv.Type()))
+	}
+
+	var errs ValidationErrors
+	validateValue(rv, "", &errs)
+	if len(errs) > 0 {
+		return Err[T](errs)
+	}
+	return Ok(v)
+}
+
+// validateValue descends into structs, Options and slices, checking tagged fields.
+func validateValue(v reflect.Value, path string, errs *ValidationErrors) {
+	if o, ok := v.Addr().Interface().(optionValue); ok {
+		if inner, ok := o.someValue(); ok {
+			validateValue(inner, path, errs)
+		}
+		return
+	}
+
+	switch v.Kind() {
+	case reflect.Struct:
+		for _, f := range validatedFields(v.Type(), nil, nil) {
+			field := v.FieldByIndex(f.index)
+			fieldPath := joinPath(path, f.name)
+			rules := v.Type().FieldByIndex(f.index).Tag.Get("jagain")
+			if rules != "" && !checkRules(field, rules, fieldPath, errs) {
+				continue
+			}
+			validateValue(field, fieldPath, errs)
+		}
+	case reflect.Slice, reflect.Array:
+		for i := 0; i < v.Len(); i++ {
+			validateValue(v.Index(i), fmt.Sprintf("%s[%d]", path, i), errs)
+		}
+	}
+}
+
+// validatedFields lists the exported fields of t that ValidateStruct checks, named by their
+// json names for error paths. Unlike taggedFields it keeps fields tagged json:"-", which
+// are named by their Go names, so that their rules are not silently ignored.
+func validatedFields(t reflect.Type, prefix []int, fields []taggedField) []taggedField {
+	for i := 0; i < t.NumField(); i++ {
+		f := t.Field(i)
+		tag := f.Tag.Get("json")
+		index := append(append([]int{}, prefix...), f.Index...)
+		if f.Anonymous && tag == "" && f.Type.Kind() == reflect.Struct {
+			fields = validatedFields(f.Type, index, fields)
+			continue
+		}
+		if !f.IsExported() {
+			continue
+		}
+
+		name, _, _ := strings.Cut(tag, ",")
+		if name == "" || tag == "-" {
+			name = f.Name
+		}
+		fields = append(fields, taggedField{name: name, index: index})
+	}
+	return fields
+}
+
+// checkRules applies the rules of one field and reports whether they all held.
+func checkRules(v reflect.Value, rules string, path string, errs *ValidationErrors) bool {
+	before := len(*errs)
+	fail := func(err error) {
+		*errs = append(*errs, &FieldError{Path: path, Err: err})
+	}
+
+	o, isOption := v.Addr().Interface().(optionValue)
+	for _, rule := range strings.Split(rules, ",") {
+		name, arg, _ := strings.Cut(strings.TrimSpace(rule), "=")
+		if name == "required" {
+			if isOption {
+				if _, ok := o.someValue(); !ok {
+					fail(errors.New("is required"))
+					return false
+				}
+			} else if v.IsZero() {
+				fail(errors.New("is required"))
+				return false
+			}
+			continue
+		}
+
+		target := v
+		if isOption {
+			inner, ok := o.someValue()
+			if !ok {
+				continue
+			}
+			target = inner
+		}
+		if err := checkRule(target, name, arg); err != nil {
+			fail(err)
+		}
+	}
+	return len(*errs) == before
+}
+
+func checkRule(v reflect.Value, name, arg string) error {
+	switch name {
+	case "min", "max":
+		limit, err := strconv.ParseFloat(arg, 64)
+		if err != nil {
+			return fmt.Errorf("invalid rule %s=%s", name, arg)
+		}
+		size, unit := measure(v)
+		if unit == "" && !isNumberKind(v.Kind()) {
+			return fmt.Errorf("rule %s does not apply to %s", name, v.Type())
+		}
+		if name == "min" && size < limit {
+			return fmt.Errorf("must be at least %s%s", arg, unit)
+		}
+		if name == "max" && size > limit {
+			return fmt.Errorf("must be at most %s%s", arg, unit)
+		}
+	case "oneof":
+		text, err := marshalText(v.Interface())
+		if err != nil {
+			return fmt.Errorf("rule oneof does not apply to %s", v.Type())
+		}
+		options := strings.Fields(arg)
+		if !slices.Contains(options, string(text)) {
+			return fmt.Errorf("must be one of %s", strings.Join(options, ", "))
+		}
+	default:
+		return fmt.Errorf("unknown rule %q", name)
+	}
+	return nil
+}
+
+// measure returns the size compared by min and max, and the unit used to describe it.
+func measure(v reflect.Value) (float64, string) {
+	switch {
+	case v.Kind() == reflect.String:
+		return float64(utf8.RuneCountInString(v.String())), " characters"
+	case v.Kind() == reflect.Slice, v.Kind() == reflect.Map, v.Kind() == reflect.Array:
+		return float64(v.Len()), " items"
+	case v.CanInt():
+		return float64(v.Int()), ""
+	case v.CanUint():
+		return float64(v.Uint()), ""
+	case v.CanFloat():
+		return v.Float(), ""
+	}
+	return 0, ""
+}
@@ -0,0 +1,84 @@
+package jagain
+
+import (
+	"strings"
+	"testing"
+)
+
+type signupAddress struct {
+	Zip string `json:"zipCode" jagain:"required,min=5,max=5"`
+}
+
+type signupForm struct {
+	Username  string                `json:"username" jagain:"required,min=3"`
+	Nickname  Option[string]        `json:"nickname" jagain:"min=2"`
+	Email     Option[string]        `json:"email" jagain:"required"`
+	Age       int                   `json:"age" jagain:"min=18,max=130"`
+	Plan      string                `json:"plan" jagain:"oneof=free pro"`
+	Tags      []string              `json:"tags" jagain:"max=2"`
+	Billing   Option[signupAddress] `json:"billing"`
+	Addresses []signupAddress       `json:"addresses"`
+}
+
+func TestValidateStruct(t *testing.T) {
+	// Test a valid struct
+	valid := signupForm{
+		Username:  "jane",
+		Email:     Some("jane@example.com"),
+		Age:       30,
+		Plan:      "pro",
+		Addresses: []signupAddress{{Zip: "12345"}},
+	}
+	if r := ValidateStruct(valid); r.IsErr() {
+		t.Errorf("Expected valid struct to pass, got %v", r.UnwrapErr())
+	}
+
+	// Test that every failure is reported with its path
+	r := ValidateStruct(signupForm{
+		Username:  "jo",
+		Nickname:  Some("j"),
+		Age:       12,
+		Plan:      "gold",
+		Tags:      []string{"a", "b", "c"},
+		Billing:   Some(signupAddress{}),
+		Addresses: []signupAddress{{Zip: "12345"}, {Zip: "123"}},
+	})
+	if r.IsOk() {
+		t.Fatalf("Expected invalid struct to fail")
+	}
+	msg := r.UnwrapErr().Error()
+	for _, want := range []string{
+		"username: must be at least 3 characters",
+		"nickname: must be at least 2 characters",
+		"email: is required",
+		"age: must be at least 18",
+		"plan: must be one of free, pro",
+		"tags: must be at most 2 items",
+		"billing.zipCode: is required",
+		"addresses[1].zipCode: must be at least 5 characters",
+	} {
+		if !strings.Contains(msg, want) {
+			t.Errorf("Expected error to contain %q, got:\n%s", want, msg)
+		}
+	}
+	if len(r.UnwrapErr().(ValidationErrors)) != 8 {
+		t.Errorf("Expected 8 field errors, got:\n%s", msg)
+	}
+
+	// Test that fields hidden from JSON are validated under their Go names
+	type credentials struct {
+		User   string `json:"user"`
+		Secret string `json:"-" jagain:"required"`
+	}
+	if r := ValidateStruct(credentials{User: "jane"}); r.IsOk() || r.UnwrapErr().Error() != "Secret: is required" {
+		t.Errorf("Expected Secret to be required, got %v", r)
+	}
+
+	// Test invalid rules
+	type badRules struct {
+		Name string `jagain:"shiny"`
+	}
+	if r := ValidateStruct(badRules{}); r.IsOk() || !strings.Contains(r.UnwrapErr().Error(), `unknown rule "shiny"`) {
+		t.Errorf("Expected unknown rule to be reported, got %v", r)
+	}
+}
@@ -0,0 +1,94 @@
+package jagain
+
+import (
+	"encoding/json"
+	"errors"
+	"strings"
+)
+
+// FieldError reports a problem with a single field of a decoded or validated value.
+// Path locates the field, for example "address.city" or "items[2].name".
+type FieldError struct {
+	Path string
+	Err  error
+}
+
+// Error implements the error interface.
+func (e *FieldError) Error() string {
+	if e.Path == "" {
+		return e.Err.Error()
+	}
+	return e.Path + ": " + e.Err.Error()
+}
+
+// Unwrap returns the underlying error.
+func (e *FieldError) Unwrap() error {
+	return e.Err
+}
+
+// MarshalJSON implements the json.Marshaler interface.
+// A FieldError is rendered as {"path": "...", "message": "..."}, omitting an empty path.
+func (e *FieldError) MarshalJSON() ([]byte, error) {
+	return json.Marshal(struct {
+		Path    string `json:"path,omitempty"`
+		Message string `json:"message"`
+	}{e.Path, e.Err.Error()})
+}
+
+// ValidationErrors accumulates field errors so that every problem with a value can be
+// reported at once. It marshals to a JSON array suitable for API responses.
+type ValidationErrors []*FieldError
+
+// Error implements the error interface, listing one field error per line.
+func (v ValidationErrors) Error() string {
+	msgs := make([]string, len(v))
+	for i, e := range v {
+		msgs[i] = e.Error()
+	}
+	return strings.Join(msgs, "\n")
+}
+
+// Unwrap returns the field errors, so errors.Is and errors.As can inspect each of them.
+func (v ValidationErrors) Unwrap() []error {
+	errs := make([]error, len(v))
+	for i, e := range v {
+		errs[i] = e
+	}
+	return errs
+}
+
+// ValidationErrorsOf flattens err into ValidationErrors. FieldErrors found in the error
+// tree are kept as they are, and any other error is reported without a path. It returns
+// nil for a nil error.
+func ValidationErrorsOf(err error) ValidationErrors {
+	if err == nil {
+		return nil
+	}
+	return collectFieldErrors(err, nil)
+}
+
+func collectFieldErrors(err error, errs ValidationErrors) ValidationErrors {
+	var fe *FieldError
+	switch e := err.(type) {
+	case *FieldError:
+		return append(errs, e)
+	case interface{ Unwrap() []error }:
+		for _, inner := range e.Unwrap() {
+			errs = collectFieldErrors(inner, errs)
+		}
+		return errs
+	case interface{ Unwrap() error }:
+		if errors.As(err, &fe) {
+			return collectFieldErrors(e.Unwrap(), errs)
+		}
+	}
+	return append(errs, &FieldError{Err: err})
+}
+
+// joinPath appends a field name to a path.
+func joinPath(path, name string) string {
+	if path == "" {
+		return name
+	}
+	return path + "." + name
+}
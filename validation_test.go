@@ -0,0 +1,47 @@
+package jagain
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"testing"
+)
+
+func TestValidationErrors(t *testing.T) {
+	errs := ValidationErrors{
+		{Path: "addresses[1].zipCode", Err: errors.New("must be 5 digits")},
+		{Err: errors.New("passwords do not match")},
+	}
+
+	// Test Error and JSON rendering
+	if errs.Error() != "addresses[1].zipCode: must be 5 digits\npasswords do not match" {
+		t.Errorf("Unexpected error message: %q", errs.Error())
+	}
+	bytes, err := json.Marshal(errs)
+	if err != nil {
+		t.Fatalf("Failed to marshal: %v", err)
+	}
+	want := `[{"path":"addresses[1].zipCode","message":"must be 5 digits"},{"message":"passwords do not match"}]`
+	if string(bytes) != want {
+		t.Errorf("Expected %s, got %s", want, bytes)
+	}
+
+	// Test errors.As through the accumulated errors
+	var fieldErr *FieldError
+	if !errors.As(fmt.Errorf("bind: %w", errs), &fieldErr) || fieldErr.Path != "addresses[1].zipCode" {
+		t.Errorf("Expected errors.As to find the first FieldError, got %v", fieldErr)
+	}
+
+	// Test ValidationErrorsOf
+	flat := ValidationErrorsOf(errors.Join(
+		errs,
+		fmt.Errorf("wrapped: %w", &FieldError{Path: "name", Err: errors.New("is required")}),
+		errors.New("plain"),
+	))
+	if len(flat) != 4 || flat[2].Path != "name" || flat[3].Path != "" || flat[3].Err.Error() != "plain" {
+		t.Errorf("Unexpected flattened errors: %v", flat)
+	}
+	if ValidationErrorsOf(nil) != nil {
+		t.Errorf("Expected nil error to give nil ValidationErrors")
+	}
+}
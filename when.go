@@ -0,0 +1,30 @@
+package jagain
+
+// When calls f and returns its value as Some if cond is true, and returns None without
+// calling f otherwise.
+func When[T any](cond bool, f func() T) Option[T] {
+	if !cond {
+		return None[T]()
+	}
+	return Some(f())
+}
+
+// Unless calls f and returns its value as Some if cond is false, and returns None without
+// calling f otherwise.
+func Unless[T any](cond bool, f func() T) Option[T] {
+	return When(!cond, f)
+}
+
+// WhenR calls f if cond is true, returning its value as Ok(Some) or its error as Err.
+// If cond is false, f is not called and the result is Ok(None), so a skipped computation
+// is not mistaken for a zero value.
+func WhenR[T any](cond bool, f func() Result[T]) Result[Option[T]] {
+	if !cond {
+		return Ok(None[T]())
+	}
+	r := f()
+	if !r.valid {
+		return Err[Option[T]](r.err)
+	}
+	return Ok(Some(r.value))
+}
@@ -0,0 +1,41 @@
+package jagain
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestWhen(t *testing.T) {
+	calls := 0
+	f := func() int { calls++; return 42 }
+
+	// Test When
+	if o := When(true, f); o.UnwrapOr(0) != 42 {
+		t.Errorf("Expected Some(42), got %v", o)
+	}
+	if o := When(false, f); o.IsSome() || calls != 1 {
+		t.Errorf("Expected None without calling f, got %v after %d calls", o, calls)
+	}
+
+	// Test Unless
+	if o := Unless(false, f); o.UnwrapOr(0) != 42 {
+		t.Errorf("Expected Some(42), got %v", o)
+	}
+	if o := Unless(true, f); o.IsSome() || calls != 2 {
+		t.Errorf("Expected None without calling f, got %v after %d calls", o, calls)
+	}
+}
+
+func TestWhenR(t *testing.T) {
+	errFailed := errors.New("failed")
+
+	if r := WhenR(true, func() Result[int] { return Ok(42) }); r.UnwrapOr(None[int]()).UnwrapOr(0) != 42 {
+		t.Errorf("Expected Ok(Some(42)), got %v", r)
+	}
+	if r := WhenR(true, func() Result[int] { return Err[int](errFailed) }); !r.IsErr() || r.UnwrapErr() != errFailed {
+		t.Errorf("Expected Err(failed), got %v", r)
+	}
+	if r := WhenR(false, func() Result[int] { panic("called") }); !r.IsOk() || r.Unwrap().IsSome() {
+		t.Errorf("Expected Ok(None), got %v", r)
+	}
+}
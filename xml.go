@@ -0,0 +1,50 @@
+package jagain
+
+import (
+	"encoding/xml"
+)
+
+// MarshalXML implements the xml.Marshaler interface.
+// None omits the element entirely.
+func (o Option[T]) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	if !o.valid {
+		return nil
+	}
+	return e.EncodeElement(o.value, start)
+}
+
+// UnmarshalXML implements the xml.Unmarshaler interface.
+// An absent element leaves the Option as None.
+func (o *Option[T]) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	var value T
+	if err := d.DecodeElement(&value, &start); err != nil {
+		return err
+	}
+
+	*o = Some(value)
+	return nil
+}
+
+// MarshalXMLAttr implements the xml.MarshalerAttr interface.
+// None omits the attribute entirely.
+func (o Option[T]) MarshalXMLAttr(name xml.Name) (xml.Attr, error) {
+	if !o.valid {
+		return xml.Attr{}, nil
+	}
+	text, err := marshalText(o.value)
+	if err != nil {
+		return xml.Attr{}, err
+	}
+	return xml.Attr{Name: name, Value: string(text)}, nil
+}
+
+// UnmarshalXMLAttr implements the xml.UnmarshalerAttr interface.
+func (o *Option[T]) UnmarshalXMLAttr(attr xml.Attr) error {
+	var value T
+	if err := unmarshalText([]byte(attr.Value), &value); err != nil {
+		return err
+	}
+
+	*o = Some(value)
+	return nil
+}
@@ -0,0 +1,62 @@
+package jagain
+
+import (
+	"encoding/xml"
+	"testing"
+)
+
+type xmlContact struct {
+	XMLName xml.Name       `xml:"contact"`
+	ID      Option[int]    `xml:"id,attr"`
+	Name    string         `xml:"name"`
+	Email   Option[string] `xml:"email"`
+	Phone   Option[string] `xml:"phone"`
+}
+
+func TestOptionXML(t *testing.T) {
+	// Test marshaling with Some and None fields
+	contact := xmlContact{
+		ID:    Some(7),
+		Name:  "Jane",
+		Email: Some("jane@example.com"),
+		Phone: None[string](),
+	}
+	bytes, err := xml.Marshal(contact)
+	if err != nil {
+		t.Fatalf("Failed to marshal contact: %v", err)
+	}
+	expected := `<contact id="7"><name>Jane</name><email>jane@example.com</email></contact>`
+	if string(bytes) != expected {
+		t.Errorf("Expected '%s', got '%s'", expected, string(bytes))
+	}
+
+	// Test omitted attribute
+	bytes, err = xml.Marshal(xmlContact{Name: "John"})
+	if err != nil {
+		t.Fatalf("Failed to marshal contact: %v", err)
+	}
+	if string(bytes) != `<contact><name>John</name></contact>` {
+		t.Errorf("Expected None attribute and elements to be omitted, got '%s'", string(bytes))
+	}
+
+	// Test unmarshaling
+	var parsed xmlContact
+	err = xml.Unmarshal([]byte(`<contact id="9"><name>Ann</name><phone>555</phone></contact>`), &parsed)
+	if err != nil {
+		t.Fatalf("Failed to unmarshal contact: %v", err)
+	}
+	if parsed.ID.UnwrapOr(0) != 9 {
+		t.Errorf("Expected ID attribute to be Some(9), got %v", parsed.ID)
+	}
+	if !parsed.Email.IsNone() {
+		t.Errorf("Expected missing email to be None, got %v", parsed.Email)
+	}
+	if parsed.Phone.UnwrapOr("") != "555" {
+		t.Errorf("Expected phone to be Some(\"555\"), got %v", parsed.Phone)
+	}
+
+	// Test invalid attribute value
+	if err := xml.Unmarshal([]byte(`<contact id="x"></contact>`), &parsed); err == nil {
+		t.Errorf("Expected invalid attribute to fail")
+	}
+}